@@ -0,0 +1,85 @@
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	chart "github.com/signalfx/signalfx-go/chart"
+)
+
+func dataSourceChart() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxChart,
+		Schema: map[string]*schema.Schema{
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the dashboard containing the chart",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Exact name of the chart",
+			},
+			// Computed values
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the chart, e.g. `TimeSeriesChart` or `SingleValue`",
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxChart(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	dashboardID := d.Get("dashboard_id").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] SignalFx: Reading dashboard %s to look up chart %q", dashboardID, name)
+	dash, err := config.Client.GetDashboard(context.TODO(), dashboardID)
+	if err != nil {
+		return err
+	}
+
+	// Dashboards only reference their charts by ID, so fetch each one
+	var found []*chart.Chart
+	for _, dc := range dash.Charts {
+		c, err := config.Client.GetChart(context.TODO(), dc.ChartId)
+		if err != nil {
+			return err
+		}
+		if c.Name == name {
+			found = append(found, c)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return fmt.Errorf("No chart named %q found in dashboard %s", name, dashboardID)
+	case 1:
+	default:
+		ids := make([]string, len(found))
+		for i, c := range found {
+			ids[i] = c.Id
+		}
+		sort.Strings(ids)
+		return fmt.Errorf("%d charts are named %q in dashboard %s: %s", len(found), name, dashboardID, strings.Join(ids, ", "))
+	}
+
+	c := found[0]
+	d.SetId(c.Id)
+	chartType := ""
+	if c.Options != nil {
+		chartType = c.Options.Type
+	}
+	return d.Set("type", chartType)
+}
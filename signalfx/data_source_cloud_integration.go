@@ -0,0 +1,136 @@
+package signalfx
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+Attributes shared by the read-only AWS, Azure and GCP integration data sources.
+The API doesn't report a sync status for GCP and Azure, so only the state that
+is available is exposed.
+*/
+func cloudIntegrationDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"integration_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "ID of the integration",
+		},
+		// Computed values
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the integration",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the integration is enabled",
+		},
+		"poll_rate": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Poll rate of the integration in seconds",
+		},
+		"last_updated": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Time the integration was last updated, in milliseconds since epoch",
+		},
+	}
+}
+
+func dataSourceAWSIntegration() *schema.Resource {
+	s := cloudIntegrationDataSourceSchema()
+	s["metric_streams_sync_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the CloudWatch Metric Streams sync, e.g. `ENABLED` or `DISABLED`. Empty if it was never set up",
+	}
+	s["logs_sync_state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the AWS logs sync, e.g. `ENABLED` or `DISABLED`. Empty if it was never set up",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceAWSIntegrationRead,
+		Schema: s,
+	}
+}
+
+func dataSourceAWSIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	aws, err := config.Client.GetAWSCloudWatchIntegration(context.TODO(), d.Get("integration_id").(string))
+	if err != nil {
+		return err
+	}
+	logIntegrationResponse(aws, "AWS")
+
+	d.SetId(aws.Id)
+	if err := setCloudIntegrationDataSourceFields(d, aws.Name, aws.Enabled, aws.PollRate, aws.LastUpdated); err != nil {
+		return err
+	}
+	if err := d.Set("metric_streams_sync_state", aws.MetricStreamsSyncState); err != nil {
+		return err
+	}
+	return d.Set("logs_sync_state", aws.LogsSyncState)
+}
+
+func dataSourceAzureIntegration() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceAzureIntegrationRead,
+		Schema: cloudIntegrationDataSourceSchema(),
+	}
+}
+
+func dataSourceAzureIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	azure, err := config.Client.GetAzureIntegration(context.TODO(), d.Get("integration_id").(string))
+	if err != nil {
+		return err
+	}
+	logIntegrationResponse(azure, "Azure")
+
+	d.SetId(azure.Id)
+	return setCloudIntegrationDataSourceFields(d, azure.Name, azure.Enabled, azure.PollRateMs, azure.LastUpdated)
+}
+
+func dataSourceGCPIntegration() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceGCPIntegrationRead,
+		Schema: cloudIntegrationDataSourceSchema(),
+	}
+}
+
+func dataSourceGCPIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	gcp, err := config.Client.GetGCPIntegration(context.TODO(), d.Get("integration_id").(string))
+	if err != nil {
+		return err
+	}
+	logIntegrationResponse(gcp, "GCP")
+
+	d.SetId(gcp.Id)
+	return setCloudIntegrationDataSourceFields(d, gcp.Name, gcp.Enabled, gcp.PollRateMs, gcp.LastUpdated)
+}
+
+func setCloudIntegrationDataSourceFields(d *schema.ResourceData, name string, enabled bool, pollRateMs int64, lastUpdated int64) error {
+	if err := d.Set("name", name); err != nil {
+		return err
+	}
+	if err := d.Set("enabled", enabled); err != nil {
+		return err
+	}
+	// We divide by 1000 because the API uses millis for every cloud
+	// integration, but this provider uses seconds like the resources do
+	if err := d.Set("poll_rate", pollRateMs/1000); err != nil {
+		return err
+	}
+	return d.Set("last_updated", lastUpdated)
+}
@@ -0,0 +1,72 @@
+package signalfx
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sfx "github.com/signalfx/signalfx-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudIntegrationDataSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/integration/AWS":
+			fmt.Fprintln(w, `{"id": "AWS", "name": "aws", "type": "AWSCloudWatch", "enabled": true, "pollRate": 300000, "lastUpdated": 1000, "metricStreamsSyncState": "ENABLED"}`)
+		case "/v2/integration/AZURE":
+			fmt.Fprintln(w, `{"id": "AZURE", "name": "azure", "type": "Azure", "enabled": false, "pollRate": 60000, "lastUpdated": 2000}`)
+		case "/v2/integration/GCP":
+			fmt.Fprintln(w, `{"id": "GCP", "name": "gcp", "type": "GCP", "enabled": true, "pollRate": 300000, "lastUpdated": 3000}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := sfx.NewClient("token", sfx.APIUrl(server.URL))
+	assert.NoError(t, err)
+	config := &signalfxConfig{Client: client}
+
+	cases := []struct {
+		id          string
+		resource    *schema.Resource
+		name        string
+		enabled     bool
+		pollRate    int
+		lastUpdated int
+	}{
+		{id: "AWS", resource: dataSourceAWSIntegration(), name: "aws", enabled: true, pollRate: 300, lastUpdated: 1000},
+		{id: "AZURE", resource: dataSourceAzureIntegration(), name: "azure", enabled: false, pollRate: 60, lastUpdated: 2000},
+		{id: "GCP", resource: dataSourceGCPIntegration(), name: "gcp", enabled: true, pollRate: 300, lastUpdated: 3000},
+	}
+	for _, tc := range cases {
+		t.Run(tc.id, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{"integration_id": tc.id})
+			assert.NoError(t, tc.resource.Read(d, config))
+			assert.Equal(t, tc.id, d.Id())
+			assert.Equal(t, tc.name, d.Get("name"))
+			assert.Equal(t, tc.enabled, d.Get("enabled"))
+			assert.Equal(t, tc.pollRate, d.Get("poll_rate"))
+			assert.Equal(t, tc.lastUpdated, d.Get("last_updated"))
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAWSIntegration().Schema, map[string]interface{}{"integration_id": "AWS"})
+	assert.NoError(t, dataSourceAWSIntegrationRead(d, config))
+	assert.Equal(t, "ENABLED", d.Get("metric_streams_sync_state"))
+	assert.Equal(t, "", d.Get("logs_sync_state"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceGCPIntegration().Schema, map[string]interface{}{"integration_id": "MISSING"})
+	assert.Error(t, dataSourceGCPIntegrationRead(d, config))
+}
+
+func TestSetCloudIntegrationDataSourceFields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, cloudIntegrationDataSourceSchema(), map[string]interface{}{})
+	assert.NoError(t, setCloudIntegrationDataSourceFields(d, "aws", true, 60000, 1000))
+	// Millis from the API, seconds in Terraform as for the resources
+	assert.Equal(t, 60, d.Get("poll_rate"))
+}
@@ -0,0 +1,119 @@
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/detector"
+)
+
+func dataSourceDetector() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxDetector,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"name", "name_regex"},
+				Description:  "Exact name of the detector. Set to the name of the found detector when using `name_regex`",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the detector must match",
+			},
+			"match_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "error",
+				ValidateFunc: validation.StringInSlice([]string{"error", "first"}, false),
+				Description:  "What to do when several detectors match `name_regex`: `error` or `first`, which picks the first by name and then ID. Defaults to `error`",
+			},
+			// Computed values
+			"program_text": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Signalflow program text of the detector",
+			},
+			"detect_labels": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Detect labels of the rules of the detector",
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxDetector(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	name := d.Get("name").(string)
+	matches := func(det *detector.Detector) bool { return det.Name == name }
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return err
+		}
+		// The API only does a partial match on names, so filter all detectors
+		name = ""
+		matches = func(det *detector.Detector) bool { return re.MatchString(det.Name) }
+	} else if d.Get("match_strategy").(string) == "first" {
+		return fmt.Errorf("match_strategy \"first\" can only be used with name_regex")
+	}
+
+	var found []*detector.Detector
+	for offset, seen := 0, 0; ; offset += int(PAGE_LIMIT) {
+		log.Printf("[DEBUG] SignalFx: Requesting detector search: name=%s, limit=%d, offset=%d", name, PAGE_LIMIT, offset)
+		resp, err := config.Client.SearchDetectors(context.TODO(), int(PAGE_LIMIT), name, offset, "")
+		if err != nil {
+			return err
+		}
+		for i := range resp.Results {
+			det := &resp.Results[i]
+			if matches(det) {
+				found = append(found, det)
+			}
+		}
+		seen += len(resp.Results)
+		if len(resp.Results) < int(PAGE_LIMIT) || seen >= int(resp.Count) {
+			break
+		}
+	}
+
+	if len(found) == 0 {
+		return fmt.Errorf("No detector found matching the search criteria")
+	}
+	if len(found) > 1 && d.Get("match_strategy").(string) != "first" {
+		return fmt.Errorf("%d detectors match the search criteria, use a more specific name_regex or match_strategy = \"first\"", len(found))
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Name != found[j].Name {
+			return found[i].Name < found[j].Name
+		}
+		return found[i].Id < found[j].Id
+	})
+	det := found[0]
+
+	log.Printf("[DEBUG] SignalFx: Found detector %s (%s)", det.Id, det.Name)
+	d.SetId(det.Id)
+	if err := d.Set("name", det.Name); err != nil {
+		return err
+	}
+	if err := d.Set("program_text", det.ProgramText); err != nil {
+		return err
+	}
+	labels := make([]string, len(det.Rules))
+	for i, rule := range det.Rules {
+		labels[i] = rule.DetectLabel
+	}
+	return d.Set("detect_labels", labels)
+}
@@ -0,0 +1,117 @@
+package signalfx
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/detector"
+)
+
+func dataSourceDetectorAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDetectorAlertsRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"detector_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the detector to look up active alerts for",
+			},
+			// Computed values
+			"active_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of currently active alerts for the detector",
+			},
+			"severity_counts": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Number of currently active alerts for the detector, keyed by severity",
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Currently active alerts for the detector",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incident_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the incident",
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Severity of the rule that triggered the alert",
+						},
+						"detect_label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Label of the rule that triggered the alert",
+						},
+						"is_muted": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the alert is currently muted",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDetectorAlertsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*signalfxConfig)
+
+	detectorID := d.Get("detector_id").(string)
+
+	var incidents []*detector.Incident
+	for offset := 0; ; offset += int(PAGE_LIMIT) {
+		log.Printf("[DEBUG] SignalFx: Requesting detector incidents: id=%s, limit=%d, offset=%d", detectorID, PAGE_LIMIT, offset)
+		page, err := config.Client.GetDetectorIncidents(ctx, detectorID, offset, int(PAGE_LIMIT))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		incidents = append(incidents, page...)
+		if len(page) < int(PAGE_LIMIT) {
+			break
+		}
+	}
+
+	alerts := make([]map[string]interface{}, 0, len(incidents))
+	severityCounts := map[string]interface{}{}
+	for _, inc := range incidents {
+		if !inc.Active {
+			continue
+		}
+		alerts = append(alerts, map[string]interface{}{
+			"incident_id":  inc.IncidentId,
+			"severity":     inc.Severity,
+			"detect_label": inc.DetectLabel,
+			"is_muted":     inc.IsMuted,
+		})
+		count, _ := severityCounts[inc.Severity].(int)
+		severityCounts[inc.Severity] = count + 1
+	}
+	log.Printf("[DEBUG] SignalFx: Detector %s has %d active alerts", detectorID, len(alerts))
+
+	if err := d.Set("active_count", len(alerts)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("severity_counts", severityCounts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("alerts", alerts); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(detectorID)
+
+	return nil
+}
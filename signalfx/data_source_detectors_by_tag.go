@@ -0,0 +1,61 @@
+package signalfx
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDetectorsByTag() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxDetectorsByTag,
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Tag the detectors must carry",
+			},
+			// Computed values
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the detectors carrying the tag, sorted",
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxDetectorsByTag(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	tag := d.Get("tag").(string)
+
+	ids := []string{}
+	for offset := 0; ; offset += int(PAGE_LIMIT) {
+		log.Printf("[DEBUG] SignalFx: Requesting detector search: tags=%s, limit=%d, offset=%d", tag, PAGE_LIMIT, offset)
+		resp, err := config.Client.SearchDetectors(context.TODO(), int(PAGE_LIMIT), "", offset, tag)
+		if err != nil {
+			return err
+		}
+		for _, det := range resp.Results {
+			ids = append(ids, det.Id)
+		}
+		if len(resp.Results) < int(PAGE_LIMIT) || len(ids) >= int(resp.Count) {
+			break
+		}
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] SignalFx: Got detectors with tag %s: %#v", tag, ids)
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	d.SetId(tag)
+
+	return nil
+}
@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"math"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // This is an arbtirary limit and could be changed. I just don't think it
@@ -22,12 +22,23 @@ func dataSourceDimensionValues() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Maximum number of values to return. Without it, the lookup fails when more than 100 values match",
+			},
 			// Computed values
 			"values": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of dimension values matching the query, which can be more than the values returned",
+			},
 		},
 	}
 }
@@ -45,22 +56,25 @@ func dataSourceReadSignalFxDimensionValue(d *schema.ResourceData, meta interface
 	debugOutput, _ := json.Marshal(resp)
 	log.Printf("[DEBUG] SignalFx: Dimension Search Response Payload: %s", string(debugOutput))
 
+	if err := d.Set("total_count", resp.Count); err != nil {
+		return err
+	}
 	if resp.Count == 0 {
 		return nil
 	}
-	if resp.Count >= PAGE_LIMIT {
-		return fmt.Errorf("This data source only allows <= %d dimensions", PAGE_LIMIT)
-	}
 
-	values := make([]string, resp.Count)
-	valueIndex := 0
-	pagesNeeded := int(math.Ceil(float64(resp.Count) / float64(PAGE_LIMIT)))
-	log.Printf("[DEBUG] SignalFx: Pages needed = %d, %f, %f", pagesNeeded, float64(PAGE_LIMIT), float64(resp.Count))
-	for i := 0; i < pagesNeeded; i++ {
+	limit, hasLimit := d.GetOk("limit")
+	if !hasLimit {
+		if resp.Count >= PAGE_LIMIT {
+			return fmt.Errorf("This data source only allows <= %d dimensions, set limit to only get the first ones", PAGE_LIMIT)
+		}
+		limit = int(PAGE_LIMIT)
+	}
 
-		if i > 0 {
+	values := []string{}
+	for offset := 0; ; offset += int(PAGE_LIMIT) {
+		if offset > 0 {
 			// If this isn't the first in the loop, fetch the next batch
-			offset := i + int(PAGE_LIMIT) + 1
 			log.Printf("[DEBUG] SignalFx: Requesting dimension search: query=%s, limit=%d, offset=%d", query, PAGE_LIMIT, offset)
 			resp, err = config.Client.SearchDimension(context.TODO(), query, "", int(PAGE_LIMIT), offset)
 			if err != nil {
@@ -68,8 +82,12 @@ func dataSourceReadSignalFxDimensionValue(d *schema.ResourceData, meta interface
 			}
 		}
 		for _, v := range resp.Results {
-			values[valueIndex] = v.Value
-			valueIndex++
+			if len(values) < limit.(int) {
+				values = append(values, v.Value)
+			}
+		}
+		if len(values) >= limit.(int) || len(resp.Results) < int(PAGE_LIMIT) || offset+len(resp.Results) >= int(resp.Count) {
+			break
 		}
 	}
 
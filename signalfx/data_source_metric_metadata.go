@@ -0,0 +1,70 @@
+package signalfx
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMetricMetadata() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxMetricMetadata,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the metric",
+			},
+			// Computed values
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the metric: `GAUGE`, `COUNTER` or `CUMULATIVE_COUNTER`",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the metric",
+			},
+			"custom_properties": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Custom properties of the metric",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags of the metric",
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxMetricMetadata(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] SignalFx: Requesting metric metadata for %s", name)
+	metric, err := config.Client.GetMetric(context.TODO(), name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(metric.Name)
+	if err := d.Set("type", metric.Type); err != nil {
+		return err
+	}
+	if err := d.Set("description", metric.Description); err != nil {
+		return err
+	}
+	if err := d.Set("custom_properties", metric.CustomProperties); err != nil {
+		return err
+	}
+	return d.Set("tags", metric.Tags)
+}
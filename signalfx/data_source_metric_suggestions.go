@@ -0,0 +1,74 @@
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceMetricSuggestions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxMetricSuggestions,
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Prefix of the metric names to suggest",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Maximum number of metric names to return. Defaults to 100",
+			},
+			// Computed values
+			"metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the metrics starting with the query, in alphabetical order",
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxMetricSuggestions(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	prefix := d.Get("query").(string)
+	limit := d.Get("limit").(int)
+	query := fmt.Sprintf("name:%s*", prefix)
+
+	metrics := make([]string, 0, limit)
+	for offset := 0; len(metrics) < limit; {
+		pageSize := int(PAGE_LIMIT)
+		if remaining := limit - len(metrics); remaining < pageSize {
+			pageSize = remaining
+		}
+		log.Printf("[DEBUG] SignalFx: Requesting metric search: query=%s, limit=%d, offset=%d", query, pageSize, offset)
+		resp, err := config.Client.SearchMetric(context.TODO(), query, "name", pageSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, m := range resp.Results {
+			metrics = append(metrics, m.Name)
+		}
+		offset += len(resp.Results)
+		if len(resp.Results) < pageSize || offset >= int(resp.Count) {
+			break
+		}
+	}
+
+	log.Printf("[DEBUG] SignalFx: Got metrics: %#v", metrics)
+	if err := d.Set("metrics", metrics); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s-%d", prefix, limit))
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package signalfx
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNotificationMigration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxNotificationMigration,
+		Schema: map[string]*schema.Schema{
+			"old_integration_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the integration or team being replaced",
+			},
+			"new_integration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the replacement integration or team, used to build the migrated notifications",
+			},
+			// Computed values
+			"detectors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Detectors with at least one rule notifying the old integration, sorted by ID",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the detector",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the detector",
+						},
+						"notifications": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Notifications of the detector referencing the old integration, rewritten to the new one if `new_integration_id` is set",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReadSignalFxNotificationMigration(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	oldID := d.Get("old_integration_id").(string)
+	newID := d.Get("new_integration_id").(string)
+
+	detectors := []map[string]interface{}{}
+	for offset, seen := 0, 0; ; offset += int(PAGE_LIMIT) {
+		log.Printf("[DEBUG] SignalFx: Requesting detector search: limit=%d, offset=%d", PAGE_LIMIT, offset)
+		resp, err := config.Client.SearchDetectors(context.TODO(), int(PAGE_LIMIT), "", offset, "")
+		if err != nil {
+			return err
+		}
+		for _, det := range resp.Results {
+			notifications := []string{}
+			for _, rule := range det.Rules {
+				for _, not := range rule.Notifications {
+					notify, err := getNotifyStringFromAPI(not)
+					if err != nil {
+						return err
+					}
+					if migrated, ok := migrateNotification(notify, oldID, newID); ok {
+						notifications = append(notifications, migrated)
+					}
+				}
+			}
+			if len(notifications) > 0 {
+				detectors = append(detectors, map[string]interface{}{
+					"id":            det.Id,
+					"name":          det.Name,
+					"notifications": dedupeSortedStrings(notifications),
+				})
+			}
+		}
+		seen += len(resp.Results)
+		if len(resp.Results) < int(PAGE_LIMIT) || seen >= int(resp.Count) {
+			break
+		}
+	}
+	sort.Slice(detectors, func(i, j int) bool {
+		return detectors[i]["id"].(string) < detectors[j]["id"].(string)
+	})
+
+	log.Printf("[DEBUG] SignalFx: Found %d detectors notifying %s", len(detectors), oldID)
+	if err := d.Set("detectors", detectors); err != nil {
+		return err
+	}
+	d.SetId(oldID)
+
+	return nil
+}
+
+/*
+The credential, team or email is always the second element of a notification
+string, e.g. `PagerDuty,credentialId`. Returns the notification with it
+replaced by newID, or unchanged if newID is empty, and whether it matched.
+*/
+func migrateNotification(notify string, oldID string, newID string) (string, bool) {
+	parts := strings.Split(notify, ",")
+	if len(parts) < 2 || parts[1] != oldID {
+		return notify, false
+	}
+	if newID != "" {
+		parts[1] = newID
+	}
+	return strings.Join(parts, ","), true
+}
+
+func dedupeSortedStrings(values []string) []string {
+	sort.Strings(values)
+	deduped := []string{}
+	for _, v := range values {
+		if len(deduped) == 0 || deduped[len(deduped)-1] != v {
+			deduped = append(deduped, v)
+		}
+	}
+	return deduped
+}
@@ -2,6 +2,7 @@ package signalfx
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -11,8 +12,9 @@ func dataSourcePagerDutyIntegration() *schema.Resource {
 		Read: dataSourcePagerDutyIntegrationRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Exact name of the PagerDuty integration",
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -25,15 +27,15 @@ func dataSourcePagerDutyIntegration() *schema.Resource {
 func dataSourcePagerDutyIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
 
-	int, err := config.Client.GetPagerDutyIntegrationByName(context.TODO(), d.Get("name").(string))
+	name := d.Get("name").(string)
+	int, err := config.Client.GetPagerDutyIntegrationByName(context.TODO(), name)
 	if err != nil {
 		return err
 	}
 
+	// An empty ID would only fail later, in the notifications using it
 	if int == nil {
-		d.SetId("")
-
-		return nil
+		return fmt.Errorf("No PagerDuty integration named %q found", name)
 	}
 
 	d.SetId(int.Id)
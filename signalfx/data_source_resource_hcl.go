@@ -0,0 +1,198 @@
+package signalfx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func dataSourceResourceHCL(p *schema.Provider) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceResourceHCLRead(ctx, d, meta, p.ResourcesMap)
+		},
+		// Not `type` and `id`: `id` is the data source's own ID attribute
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Type of the resource to export, e.g. `signalfx_detector`",
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "ID of the object to export",
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "this",
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the resource in the generated HCL. Defaults to `this`",
+			},
+			// Computed values
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Best-effort HCL representation of the object",
+			},
+		},
+	}
+}
+
+func dataSourceResourceHCLRead(ctx context.Context, d *schema.ResourceData, meta interface{}, resources map[string]*schema.Resource) diag.Diagnostics {
+	resourceType := d.Get("resource_type").(string)
+	id := d.Get("resource_id").(string)
+
+	res, ok := resources[resourceType]
+	if !ok {
+		return diag.Errorf("Unknown resource type %q", resourceType)
+	}
+
+	log.Printf("[DEBUG] SignalFx: Reading %s %s to export as HCL", resourceType, id)
+	state, diags := res.RefreshWithoutUpgrade(ctx, &terraform.InstanceState{ID: id}, meta)
+	if diags.HasError() {
+		return diags
+	}
+	if state == nil || state.ID == "" {
+		return diag.Errorf("%s %s not found", resourceType, id)
+	}
+
+	rd := res.Data(state)
+	values := make(map[string]interface{}, len(res.Schema))
+	for k := range res.Schema {
+		values[k] = rd.Get(k)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "resource %q %q {\n", resourceType, d.Get("resource_name").(string))
+	writeHCLBody(&buf, res.Schema, values, 1)
+	buf.WriteString("}\n")
+
+	if err := d.Set("hcl", buf.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resourceType + ":" + id)
+
+	return diags
+}
+
+/*
+Writes the arguments of a resource or nested block. Computed-only,
+deprecated and sensitive attributes are skipped, as are values left to
+their default.
+*/
+func writeHCLBody(buf *bytes.Buffer, s map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	indent := strings.Repeat("  ", depth)
+	for _, k := range keys {
+		sch := s[k]
+		if (sch.Computed && !sch.Optional && !sch.Required) || sch.Deprecated != "" || sch.Sensitive {
+			continue
+		}
+		v, ok := values[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		switch sch.Type {
+		case schema.TypeList, schema.TypeSet:
+			var items []interface{}
+			if set, ok := v.(*schema.Set); ok {
+				items = set.List()
+			} else {
+				items, _ = v.([]interface{})
+			}
+			if block, ok := sch.Elem.(*schema.Resource); ok {
+				for _, item := range items {
+					m, _ := item.(map[string]interface{})
+					fmt.Fprintf(buf, "%s%s {\n", indent, k)
+					writeHCLBody(buf, block.Schema, m, depth+1)
+					fmt.Fprintf(buf, "%s}\n", indent)
+				}
+				continue
+			}
+			if len(items) == 0 {
+				continue
+			}
+			formatted := make([]string, len(items))
+			for i, item := range items {
+				formatted[i] = formatHCLValue(item, indent)
+			}
+			fmt.Fprintf(buf, "%s%s = [%s]\n", indent, k, strings.Join(formatted, ", "))
+		case schema.TypeMap:
+			m, _ := v.(map[string]interface{})
+			if len(m) == 0 {
+				continue
+			}
+			mapKeys := make([]string, 0, len(m))
+			for mk := range m {
+				mapKeys = append(mapKeys, mk)
+			}
+			sort.Strings(mapKeys)
+			fmt.Fprintf(buf, "%s%s = {\n", indent, k)
+			for _, mk := range mapKeys {
+				fmt.Fprintf(buf, "%s  %s = %s\n", indent, strconv.Quote(mk), formatHCLValue(m[mk], indent+"  "))
+			}
+			fmt.Fprintf(buf, "%s}\n", indent)
+		default:
+			if !sch.Required && isDefaultHCLValue(sch, v) {
+				continue
+			}
+			fmt.Fprintf(buf, "%s%s = %s\n", indent, k, formatHCLValue(v, indent))
+		}
+	}
+}
+
+func isDefaultHCLValue(sch *schema.Schema, v interface{}) bool {
+	if sch.Default != nil {
+		return fmt.Sprint(sch.Default) == fmt.Sprint(v)
+	}
+	switch val := v.(type) {
+	case string:
+		return val == ""
+	case int:
+		return val == 0
+	case float64:
+		return val == 0
+	case bool:
+		return !val
+	}
+	return false
+}
+
+func formatHCLValue(v interface{}, indent string) string {
+	switch val := v.(type) {
+	case string:
+		// Escape template sequences so they are kept literally
+		escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(val)
+		if strings.Contains(val, "\n") && !strings.Contains(val, "\nEOF") {
+			return "<<-EOF\n" + strings.TrimRight(escaped, "\n") + "\n" + indent + "EOF"
+		}
+		return strconv.Quote(escaped)
+	case int:
+		return strconv.Itoa(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	default:
+		return strconv.Quote(fmt.Sprint(val))
+	}
+}
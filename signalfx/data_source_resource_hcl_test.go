@@ -0,0 +1,150 @@
+package signalfx
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteHCLBody(t *testing.T) {
+	rule := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"severity": {Type: schema.TypeString, Required: true},
+			"disabled": {Type: schema.TypeBool, Optional: true, Default: false},
+		},
+	}
+	tags := &schema.Schema{Type: schema.TypeString}
+
+	cases := []struct {
+		name     string
+		schema   map[string]*schema.Schema
+		values   map[string]interface{}
+		expected string
+	}{
+		{
+			name: "skips computed, sensitive, deprecated and default values",
+			schema: map[string]*schema.Schema{
+				"name":        {Type: schema.TypeString, Required: true},
+				"url":         {Type: schema.TypeString, Computed: true},
+				"token":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"old":         {Type: schema.TypeString, Optional: true, Deprecated: "Use name"},
+				"description": {Type: schema.TypeString, Optional: true},
+				"max_delay":   {Type: schema.TypeInt, Optional: true},
+				"min_delay":   {Type: schema.TypeInt, Optional: true, Default: 60},
+				"threshold":   {Type: schema.TypeFloat, Optional: true},
+			},
+			values: map[string]interface{}{
+				"name":        "cpu",
+				"url":         "https://example.com",
+				"token":       "secret",
+				"old":         "legacy",
+				"description": "",
+				"max_delay":   0,
+				"min_delay":   60,
+				"threshold":   0.5,
+			},
+			expected: "  name = \"cpu\"\n  threshold = 0.5\n",
+		},
+		{
+			name: "nested blocks",
+			schema: map[string]*schema.Schema{
+				"rule": {Type: schema.TypeList, Optional: true, Elem: rule},
+			},
+			values: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"severity": "Critical", "disabled": true},
+					map[string]interface{}{"severity": "Warning", "disabled": false},
+				},
+			},
+			expected: "  rule {\n    disabled = true\n    severity = \"Critical\"\n  }\n" +
+				"  rule {\n    severity = \"Warning\"\n  }\n",
+		},
+		{
+			name: "sets",
+			schema: map[string]*schema.Schema{
+				"tags":  {Type: schema.TypeSet, Optional: true, Elem: tags},
+				"rules": {Type: schema.TypeSet, Optional: true, Elem: rule},
+				"empty": {Type: schema.TypeSet, Optional: true, Elem: tags},
+			},
+			values: map[string]interface{}{
+				"tags": schema.NewSet(schema.HashSchema(tags), []interface{}{"prod"}),
+				"rules": schema.NewSet(schema.HashResource(rule), []interface{}{
+					map[string]interface{}{"severity": "Major", "disabled": false},
+				}),
+				"empty": schema.NewSet(schema.HashSchema(tags), nil),
+			},
+			expected: "  rules {\n    severity = \"Major\"\n  }\n  tags = [\"prod\"]\n",
+		},
+		{
+			name: "maps",
+			schema: map[string]*schema.Schema{
+				"labels": {Type: schema.TypeMap, Optional: true, Elem: tags},
+				"empty":  {Type: schema.TypeMap, Optional: true, Elem: tags},
+			},
+			values: map[string]interface{}{
+				"labels": map[string]interface{}{"team": "sre", "env": "prod"},
+				"empty":  map[string]interface{}{},
+			},
+			expected: "  labels = {\n    \"env\" = \"prod\"\n    \"team\" = \"sre\"\n  }\n",
+		},
+		{
+			name: "multi-line strings",
+			schema: map[string]*schema.Schema{
+				"program_text": {Type: schema.TypeString, Required: true},
+				"body":         {Type: schema.TypeString, Optional: true},
+			},
+			values: map[string]interface{}{
+				"program_text": "A = data('cpu').publish()\nB = data('mem').publish()\n",
+				"body":         "keeps\nEOF\nliteral",
+			},
+			expected: "  body = \"keeps\\nEOF\\nliteral\"\n" +
+				"  program_text = <<-EOF\nA = data('cpu').publish()\nB = data('mem').publish()\n  EOF\n",
+		},
+		{
+			name: "template sequences are escaped",
+			schema: map[string]*schema.Schema{
+				"subject": {Type: schema.TypeString, Required: true},
+				"body":    {Type: schema.TypeString, Required: true},
+			},
+			values: map[string]interface{}{
+				"subject": "{{ruleName}} ${var} %{if}",
+				"body":    "Value: ${value}\nRule: %{rule}",
+			},
+			expected: "  body = <<-EOF\nValue: $${value}\nRule: %%{rule}\n  EOF\n" +
+				"  subject = \"{{ruleName}} $${var} %%{if}\"\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeHCLBody(&buf, tc.schema, tc.values, 1)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestIsDefaultHCLValue(t *testing.T) {
+	assert.True(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeInt, Default: 60}, 60))
+	assert.False(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeInt, Default: 60}, 0))
+	assert.True(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeBool}, false))
+	assert.False(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeBool, Default: true}, false))
+	assert.True(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeString}, ""))
+	assert.False(t, isDefaultHCLValue(&schema.Schema{Type: schema.TypeFloat}, 1.5))
+}
+
+func TestDataSourceResourceHCLUsesProviderResources(t *testing.T) {
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{}}
+	ds := dataSourceResourceHCL(p)
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"resource_type": "signalfx_detector",
+		"resource_id":   "ABC123",
+	})
+	diags := ds.ReadContext(context.Background(), d, nil)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `Unknown resource type "signalfx_detector"`, diags[0].Summary)
+}
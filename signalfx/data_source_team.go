@@ -0,0 +1,95 @@
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/team"
+)
+
+func dataSourceTeam() *schema.Resource {
+	notificationList := func(severity string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: fmt.Sprintf("Where to send notifications for %s alerts", severity),
+		}
+	}
+
+	return &schema.Resource{
+		Read: dataSourceReadSignalFxTeam,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the team",
+			},
+			// Computed values
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the team",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Members of the team",
+			},
+			"notifications_critical": notificationList("critical"),
+			"notifications_default":  notificationList("default"),
+			"notifications_info":     notificationList("info"),
+			"notifications_major":    notificationList("major"),
+			"notifications_minor":    notificationList("minor"),
+			"notifications_warning":  notificationList("warning"),
+		},
+	}
+}
+
+func dataSourceReadSignalFxTeam(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	name := d.Get("name").(string)
+
+	// The search does a partial match on names, so keep the exact matches
+	var found []team.Team
+	for offset, seen := 0, 0; ; offset += int(PAGE_LIMIT) {
+		log.Printf("[DEBUG] SignalFx: Requesting team search: name=%s, limit=%d, offset=%d", name, PAGE_LIMIT, offset)
+		resp, err := config.Client.SearchTeam(context.TODO(), int(PAGE_LIMIT), name, offset, "")
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.Results {
+			if t.Name == name {
+				found = append(found, t)
+			}
+		}
+		seen += len(resp.Results)
+		if len(resp.Results) < int(PAGE_LIMIT) || seen >= int(resp.Count) {
+			break
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return fmt.Errorf("No team named %q found", name)
+	case 1:
+	default:
+		ids := make([]string, len(found))
+		for i, t := range found {
+			ids[i] = t.Id
+		}
+		sort.Strings(ids)
+		return fmt.Errorf("%d teams are named %q: %s", len(found), name, strings.Join(ids, ", "))
+	}
+
+	d.SetId(found[0].Id)
+	return teamAPIToTF(d, &found[0])
+}
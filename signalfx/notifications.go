@@ -9,24 +9,28 @@ import (
 )
 
 const (
-	BigPandaNotificationType   string = "BigPanda"
-	EmailNotificationType      string = "Email"
-	JiraNotificationType       string = "Jira"
-	Office365NotificationType  string = "Office365"
-	OpsgenieNotificationType   string = "Opsgenie"
-	PagerDutyNotificationType  string = "PagerDuty"
-	ServiceNowNotificationType string = "ServiceNow"
-	SlackNotificationType      string = "Slack"
-	TeamNotificationType       string = "Team"
-	TeamEmailNotificationType  string = "TeamEmail"
-	VictorOpsNotificationType  string = "VictorOps"
-	WebhookNotificationType    string = "Webhook"
-	XMattersNotificationType   string = "XMatters"
+	AmazonEventBridgeNotificationType string = "AmazonEventBridge"
+	BigPandaNotificationType          string = "BigPanda"
+	EmailNotificationType             string = "Email"
+	JiraNotificationType              string = "Jira"
+	Office365NotificationType         string = "Office365"
+	OpsgenieNotificationType          string = "Opsgenie"
+	PagerDutyNotificationType         string = "PagerDuty"
+	ServiceNowNotificationType        string = "ServiceNow"
+	SlackNotificationType             string = "Slack"
+	TeamNotificationType              string = "Team"
+	TeamEmailNotificationType         string = "TeamEmail"
+	VictorOpsNotificationType         string = "VictorOps"
+	WebhookNotificationType           string = "Webhook"
+	XMattersNotificationType          string = "XMatters"
 )
 
 func getNotifyStringFromAPI(not *notification.Notification) (string, error) {
 	nt := not.Type
 	switch nt {
+	case AmazonEventBridgeNotificationType:
+		eb := not.Value.(*notification.AmazonEventBrigeNotification)
+		return fmt.Sprintf("%s,%s", nt, eb.CredentialId), nil
 	case BigPandaNotificationType:
 		bp := not.Value.(*notification.BigPandaNotification)
 		return fmt.Sprintf("%s,%s", nt, bp.CredentialId), nil
@@ -79,6 +83,11 @@ func getNotifications(tfNotifications []interface{}) ([]*notification.Notificati
 		var n interface{}
 
 		switch vars[0] {
+		case AmazonEventBridgeNotificationType:
+			n = &notification.AmazonEventBrigeNotification{
+				Type:         vars[0],
+				CredentialId: vars[1],
+			}
 		case BigPandaNotificationType:
 			n = &notification.BigPandaNotification{
 				Type:         vars[0],
@@ -166,18 +175,41 @@ func getNotifications(tfNotifications []interface{}) ([]*notification.Notificati
 	return notificationsList, nil
 }
 
+/*
+Validates a notification string at plan time. The key contains the index of
+the element, e.g. rule.0.notifications.1, and is prefixed to the errors so a
+malformed element is easy to find.
+*/
 func validateNotification(val interface{}, key string) (warns []string, errs []error) {
+	defer func() {
+		for i, err := range errs {
+			errs[i] = fmt.Errorf("%s: %s", key, err)
+		}
+	}()
+
 	parts := strings.Split(val.(string), ",")
 	partCount := len(parts)
 	if partCount < 2 {
-		errs = append(errs, fmt.Errorf("Invalid notification string, not enough commas"))
+		errs = append(errs, fmt.Errorf("Invalid notification string %q, not enough commas", val.(string)))
 		return
 	}
 
 	switch parts[0] {
 	case BigPandaNotificationType, JiraNotificationType, Office365NotificationType, ServiceNowNotificationType, PagerDutyNotificationType, TeamNotificationType, TeamEmailNotificationType, XMattersNotificationType:
-		// These are ok, but have no further validation
+		if partCount != 2 {
+			errs = append(errs, fmt.Errorf("Invalid %s notification string %q, expected \"%s,<id>\"", parts[0], val.(string), parts[0]))
+			return
+		}
+	case AmazonEventBridgeNotificationType:
+		if partCount != 2 {
+			errs = append(errs, fmt.Errorf("Invalid AmazonEventBridge notification string %q, expected \"AmazonEventBridge,<credentialId>\"", val.(string)))
+			return
+		}
 	case EmailNotificationType:
+		if partCount != 2 {
+			errs = append(errs, fmt.Errorf("Invalid Email notification string %q, expected \"Email,<address>\"", val.(string)))
+			return
+		}
 		if !strings.Contains(parts[1], "@") {
 			errs = append(errs, fmt.Errorf("No @ detected in %q, bad email?", parts[1]))
 			return
@@ -224,7 +256,7 @@ func validateNotification(val interface{}, key string) (warns []string, errs []e
 		if parts[3] != "" {
 			_, err := url.ParseRequestURI(parts[3])
 			if err != nil {
-				errs = append(errs, fmt.Errorf("Invalid Webhook URL %q", parts[2]))
+				errs = append(errs, fmt.Errorf("Invalid Webhook URL %q", parts[3]))
 				return
 			}
 		}
@@ -113,6 +113,13 @@ func TestNotifyStringFromAPI(t *testing.T) {
 				CredentialId: "XXX",
 			},
 		},
+		&notification.Notification{
+			Type: AmazonEventBridgeNotificationType,
+			Value: &notification.AmazonEventBrigeNotification{
+				Type:         AmazonEventBridgeNotificationType,
+				CredentialId: "XXX",
+			},
+		},
 	}
 
 	expected := []string{
@@ -130,6 +137,7 @@ func TestNotifyStringFromAPI(t *testing.T) {
 		"ServiceNow,XXX",
 		"VictorOps,XXX,YYY",
 		"XMatters,XXX",
+		"AmazonEventBridge,XXX",
 	}
 
 	for i, v := range values {
@@ -163,6 +171,11 @@ func TestNotifyValidationBad(t *testing.T) {
 		"ServiceNow",
 		"VictorOps,XXX",
 		"XMatters",
+		"AmazonEventBridge",
+		"AmazonEventBridge,XXX,YYY",
+		"PagerDuty,XXX,YYY",
+		"Team,ABC123,",
+		"Email,foo@example.com,bar@example.com",
 	}
 
 	for _, v := range busted {
@@ -171,6 +184,18 @@ func TestNotifyValidationBad(t *testing.T) {
 	}
 }
 
+func TestNotifyValidationPointsAtElement(t *testing.T) {
+	_, errors := validateNotification("Slcak,abc", "rule.0.notifications.1")
+	assert.Len(t, errors, 1)
+	assert.EqualError(t, errors[0], `rule.0.notifications.1: Invalid notification type "Slcak"`)
+}
+
+func TestNotifyValidationAmazonEventBridge(t *testing.T) {
+	_, errors := validateNotification("AmazonEventBridge,XXX,YYY", "notification")
+	assert.Len(t, errors, 1)
+	assert.EqualError(t, errors[0], `notification: Invalid AmazonEventBridge notification string "AmazonEventBridge,XXX,YYY", expected "AmazonEventBridge,<credentialId>"`)
+}
+
 func TestGetNotifications(t *testing.T) {
 	values := []interface{}{
 		"Email,test@yelp.com",
@@ -186,6 +211,7 @@ func TestGetNotifications(t *testing.T) {
 		"ServiceNow,credId",
 		"VictorOps,credId,routingKey",
 		"XMatters,credId",
+		"AmazonEventBridge,credId",
 	}
 
 	expected := []*notification.Notification{
@@ -287,6 +313,13 @@ func TestGetNotifications(t *testing.T) {
 				CredentialId: "credId",
 			},
 		},
+		&notification.Notification{
+			Type: AmazonEventBridgeNotificationType,
+			Value: &notification.AmazonEventBrigeNotification{
+				Type:         AmazonEventBridgeNotificationType,
+				CredentialId: "credId",
+			},
+		},
 	}
 	nots, err := getNotifications(values)
 	assert.NoError(t, err, "No error expected on notification conversion")
@@ -1,21 +1,27 @@
 package signalfx
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
 	"os"
+	"os/exec"
 	"os/user"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/bgentry/go-netrc/netrc"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 	sfx "github.com/signalfx/signalfx-go"
 
@@ -28,11 +34,19 @@ var HomeConfigPath = ""
 
 var sfxProvider *schema.Provider
 
+// Headers set by the client for authentication, which custom_headers can't override
+var reservedHeaders = []string{"Authorization", "X-Sf-Token"}
+
 type signalfxConfig struct {
-	AuthToken    string `json:"auth_token"`
-	APIURL       string `json:"api_url"`
-	CustomAppURL string `json:"custom_app_url"`
-	Client       *sfx.Client
+	AuthToken     string            `json:"auth_token"`
+	APIURL        string            `json:"api_url"`
+	CustomAppURL  string            `json:"custom_app_url"`
+	CustomHeaders map[string]string `json:"custom_headers"`
+	Client        *sfx.Client
+
+	DefaultMinDelay       int
+	FailOnDeprecation     bool
+	IgnoreUpdateConflicts bool
 }
 
 func Provider() *schema.Provider {
@@ -44,6 +58,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SFX_AUTH_TOKEN", ""),
 				Description: "Splunk Observability Cloud auth token",
 			},
+			"auth_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SFX_AUTH_TOKEN_COMMAND", ""),
+				Description: "Command printing the Splunk Observability Cloud auth token on stdout, used when auth_token isn't set",
+			},
 			"api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -63,27 +83,66 @@ func Provider() *schema.Provider {
 				Description: "Timeout duration for a single HTTP call in seconds. Defaults to 120",
 			},
 			"retry_max_attempts": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     4,
-				Description: "Max retries for a single HTTP call. Defaults to 4",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SFX_RETRY_MAX_ATTEMPTS", 4),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Max retries for a single HTTP call. Defaults to 4",
 			},
 			"retry_wait_min_seconds": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     1,
-				Description: "Minimum retry wait for a single HTTP call in seconds. Defaults to 1",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SFX_RETRY_WAIT_MIN_SECONDS", 1),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum retry wait for a single HTTP call in seconds. Defaults to 1",
 			},
 			"retry_wait_max_seconds": {
-				Type:        schema.TypeInt,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SFX_RETRY_WAIT_MAX_SECONDS", 30),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum retry wait for a single HTTP call in seconds. Defaults to 30",
+			},
+			"custom_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional HTTP headers to set on every API call, e.g. for an egress proxy. The `Authorization` and `X-SF-Token` headers can't be set",
+			},
+			"default_min_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 900),
+				Description:  "Minimum delay (in seconds) applied to detectors that don't set min_delay. Defaults to 0",
+			},
+			"fail_on_deprecation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SFX_FAIL_ON_DEPRECATION", false),
+				Description: "Fail when the Splunk Observability Cloud API flags a request as deprecated instead of logging a warning. Defaults to false",
+			},
+			"ignore_update_conflicts": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     30,
-				Description: "Maximum retry wait for a single HTTP call in seconds. Defaults to 30",
+				DefaultFunc: schema.EnvDefaultFunc("SFX_IGNORE_UPDATE_CONFLICTS", false),
+				Description: "Update detectors, dashboards and charts even if they were modified outside of Terraform since they were last read. Defaults to false",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"signalfx_dimension_values":      dataSourceDimensionValues(),
-			"signalfx_pagerduty_integration": dataSourcePagerDutyIntegration(),
+			"signalfx_aws_integration":        dataSourceAWSIntegration(),
+			"signalfx_azure_integration":      dataSourceAzureIntegration(),
+			"signalfx_chart":                  dataSourceChart(),
+			"signalfx_detector":               dataSourceDetector(),
+			"signalfx_detector_alerts":        dataSourceDetectorAlerts(),
+			"signalfx_detectors_by_tag":       dataSourceDetectorsByTag(),
+			"signalfx_dimension_values":       dataSourceDimensionValues(),
+			"signalfx_gcp_integration":        dataSourceGCPIntegration(),
+			"signalfx_metric_metadata":        dataSourceMetricMetadata(),
+			"signalfx_metric_suggestions":     dataSourceMetricSuggestions(),
+			"signalfx_notification_migration": dataSourceNotificationMigration(),
+			"signalfx_pagerduty_integration":  dataSourcePagerDutyIntegration(),
+			"signalfx_team":                   dataSourceTeam(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"signalfx_alert_muting_rule":        alertMutingRuleResource(),
@@ -119,6 +178,8 @@ func Provider() *schema.Provider {
 		},
 		ConfigureFunc: signalfxConfigure,
 	}
+	// Needs the resources of the provider it's registered on
+	sfxProvider.DataSourcesMap["signalfx_resource_hcl"] = dataSourceResourceHCL(sfxProvider)
 
 	return sfxProvider
 }
@@ -159,6 +220,12 @@ func signalfxConfigure(data *schema.ResourceData) (interface{}, error) {
 	// provider is the top priority
 	if token, ok := data.GetOk("auth_token"); ok {
 		config.AuthToken = token.(string)
+	} else if command, ok := data.GetOk("auth_token_command"); ok {
+		token, err := runAuthTokenCommand(command.(string))
+		if err != nil {
+			return &config, err
+		}
+		config.AuthToken = token
 	}
 
 	if config.AuthToken == "" {
@@ -170,6 +237,15 @@ func signalfxConfigure(data *schema.ResourceData) (interface{}, error) {
 	if customAppURL, ok := data.GetOk("custom_app_url"); ok {
 		config.CustomAppURL = customAppURL.(string)
 	}
+	config.DefaultMinDelay = data.Get("default_min_delay").(int)
+	config.FailOnDeprecation = data.Get("fail_on_deprecation").(bool)
+	config.IgnoreUpdateConflicts = data.Get("ignore_update_conflicts").(bool)
+
+	customHeaders, err := buildCustomHeaders(config.CustomHeaders, data.Get("custom_headers").(map[string]interface{}))
+	if err != nil {
+		return &config, err
+	}
+	config.CustomHeaders = customHeaders
 
 	netTransport := logging.NewTransport("SignalFx", &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -197,9 +273,18 @@ func signalfxConfigure(data *schema.ResourceData) (interface{}, error) {
 	retryClient.RetryMax = retryMaxAttempts
 	retryClient.RetryWaitMin = time.Second * time.Duration(int64(retryWaitMinSeconds))
 	retryClient.RetryWaitMax = time.Second * time.Duration(int64(retryWaitMaxSeconds))
-	retryClient.HTTPClient.Transport = netTransport
+	retryClient.CheckRetry = retryPolicy
+	retryClient.HTTPClient.Transport = &headersTransport{
+		next:    netTransport,
+		headers: config.CustomHeaders,
+	}
 	standardClient := retryClient.StandardClient()
 	standardClient.Timeout = time.Second * time.Duration(int64(totalTimeoutSeconds))
+	// Wrap outside of the retries so deprecated requests aren't retried
+	standardClient.Transport = &deprecationTransport{
+		next: standardClient.Transport,
+		fail: config.FailOnDeprecation,
+	}
 
 	client, err := sfx.NewClient(config.AuthToken,
 		sfx.APIUrl(config.APIURL),
@@ -227,6 +312,33 @@ func readConfigFile(configPath string, config *signalfxConfig) error {
 	return nil
 }
 
+/*
+Runs the command through the shell, like git credential helpers, so that
+short-lived tokens can be fetched from e.g. Vault without writing them to disk.
+*/
+func runAuthTokenCommand(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] SignalFx: Running auth token command")
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("auth_token_command failed: %s: %s", err.Error(), strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("auth_token_command returned an empty token")
+	}
+	return token, nil
+}
+
 func readNetrcFile(config *signalfxConfig) error {
 	// Inspired by https://github.com/hashicorp/terraform/blob/master/vendor/github.com/hashicorp/go-getter/netrc.go
 	// Get the netrc file path
@@ -274,3 +386,111 @@ func readNetrcFile(config *signalfxConfig) error {
 	config.AuthToken = machine.Password
 	return nil
 }
+
+/*
+Merges the custom headers from the config files with the ones from the
+provider configuration, which win on conflicts. Names are compared case
+insensitively.
+*/
+func buildCustomHeaders(fromFile map[string]string, fromSchema map[string]interface{}) (map[string]string, error) {
+	headers := make(map[string]string, len(fromFile)+len(fromSchema))
+	for k, v := range fromFile {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	for k, v := range fromSchema {
+		headers[http.CanonicalHeaderKey(k)] = v.(string)
+	}
+
+	for _, reserved := range reservedHeaders {
+		if _, ok := headers[reserved]; ok {
+			return nil, fmt.Errorf("custom_headers: the %s header is reserved and can't be set", reserved)
+		}
+	}
+	return headers, nil
+}
+
+type headersTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.headers) == 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTrippers must not modify the request they are given
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return t.next.RoundTrip(req)
+}
+
+/*
+Rate limited (429) and unavailable (503) responses are retried for every
+request, honoring Retry-After. Other server errors are only retried for
+methods that are safe to repeat, as a failed POST may still have created the
+object. Connection errors are retried like the default policy does.
+*/
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	shouldRetry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if !shouldRetry || checkErr != nil || resp == nil {
+		return shouldRetry, checkErr
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return true, nil
+	}
+	switch resp.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+/*
+Checks API responses for the headers used to announce deprecated endpoints and
+either logs them or, with fail_on_deprecation set, fails the request. The
+request has already run by then, so only reads (GET and HEAD) fail. Failing a
+write would report an error for a change the API already applied, e.g. leave a
+created object outside the state or a deleted one in it.
+*/
+type deprecationTransport struct {
+	next http.RoundTripper
+	fail bool
+}
+
+func (t *deprecationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	notice := deprecationNotice(resp.Header)
+	if notice == "" {
+		return resp, nil
+	}
+	if !t.fail || (req.Method != http.MethodGet && req.Method != http.MethodHead) {
+		log.Printf("[WARN] SignalFx: %s %s is deprecated: %s", req.Method, req.URL.Path, notice)
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return nil, fmt.Errorf("%s %s is deprecated: %s", req.Method, req.URL.Path, notice)
+}
+
+func deprecationNotice(header http.Header) string {
+	var notices []string
+	for _, name := range []string{"Deprecation", "Sunset", "Warning"} {
+		for _, v := range header.Values(name) {
+			// Other warn-codes, e.g. 110 Response is Stale from a proxy, aren't deprecations
+			if name == "Warning" && !strings.HasPrefix(strings.TrimSpace(v), "299 ") {
+				continue
+			}
+			notices = append(notices, fmt.Sprintf("%s: %s", name, v))
+		}
+	}
+	return strings.Join(notices, ", ")
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"runtime"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -150,6 +153,25 @@ func TestProviderConfigureFromTerraformOnly(t *testing.T) {
 	assert.Equal(t, "https://myotherdomain.signalfx.com", configuration.CustomAppURL)
 }
 
+func TestProviderConfigureDefaultMinDelay(t *testing.T) {
+	defer resetGlobals()
+	SystemConfigPath = "filedoesnotexist"
+	HomeConfigPath = "filedoesnotexist"
+	raw := map[string]interface{}{
+		"auth_token":        "XXX",
+		"default_min_delay": 30,
+	}
+
+	rp := Provider()
+	diag := rp.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	meta := rp.Meta()
+	if meta == nil {
+		t.Fatalf("Expected metadata, got nil. err: %s", spew.Sdump(diag))
+	}
+	configuration := meta.(*signalfxConfig)
+	assert.Equal(t, 30, configuration.DefaultMinDelay)
+}
+
 func TestProviderConfigureFromEnvironment(t *testing.T) {
 	defer resetGlobals()
 	tmpfileSystem, err := createTempConfigFile(`{"useless_config":"foo","auth_token":"ZZZ"}`, "signalfx.conf")
@@ -399,3 +421,155 @@ func TestReadConfigFileSuccess(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "XXX", config.AuthToken)
 }
+
+func TestDeprecationTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/old" {
+			w.Header().Set("Sunset", "Sat, 01 Mar 2025 00:00:00 GMT")
+			w.Header().Add("Warning", `299 - "Deprecated API"`)
+		}
+		if r.URL.Path == "/v2/stale" {
+			w.Header().Add("Warning", `110 proxy "Response is Stale"`)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, fail := range []bool{false, true} {
+		client := &http.Client{Transport: &deprecationTransport{next: http.DefaultTransport, fail: fail}}
+
+		resp, err := client.Get(server.URL + "/v2/current")
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		resp, err = client.Get(server.URL + "/v2/stale")
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		// Writes were applied already, failing now would lose track of them
+		for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			req, _ := http.NewRequest(method, server.URL+"/v2/old", nil)
+			resp, err = client.Do(req)
+			assert.NoError(t, err, method)
+			resp.Body.Close()
+		}
+
+		resp, err = client.Get(server.URL + "/v2/old")
+		if fail {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "Sunset: Sat, 01 Mar 2025 00:00:00 GMT")
+			assert.Contains(t, err.Error(), `Warning: 299 - "Deprecated API"`)
+		} else {
+			assert.NoError(t, err)
+			resp.Body.Close()
+		}
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	response := func(method string, status int) *http.Response {
+		return &http.Response{StatusCode: status, Request: &http.Request{Method: method}}
+	}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		method string
+		status int
+		retry  bool
+	}{
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusServiceUnavailable, true},
+		{http.MethodPost, http.StatusInternalServerError, false},
+		{http.MethodGet, http.StatusInternalServerError, true},
+		{http.MethodPut, http.StatusBadGateway, true},
+		{http.MethodDelete, http.StatusBadRequest, false},
+		{http.MethodGet, http.StatusOK, false},
+	} {
+		retry, err := retryPolicy(ctx, response(tc.method, tc.status), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.retry, retry, "%s with status %d", tc.method, tc.status)
+	}
+}
+
+func TestProviderConfigureCustomHeaders(t *testing.T) {
+	defer resetGlobals()
+	tmpfileHome, err := createTempConfigFile(`{"custom_headers":{"x-request-source":"file","X-Team":"file"}}`, "signalfx.conf")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.Remove(tmpfileHome.Name())
+	SystemConfigPath = "filedoesnotexist"
+	HomeConfigPath = tmpfileHome.Name()
+
+	raw := map[string]interface{}{
+		"auth_token": "XXX",
+		"custom_headers": map[string]interface{}{
+			"x-team": "observability",
+		},
+	}
+
+	rp := Provider()
+	diag := rp.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	meta := rp.Meta()
+	if meta == nil {
+		t.Fatalf("Expected metadata, got nil. err: %s", spew.Sdump(diag))
+	}
+	configuration := meta.(*signalfxConfig)
+	assert.Equal(t, map[string]string{"X-Request-Source": "file", "X-Team": "observability"}, configuration.CustomHeaders)
+}
+
+func TestProviderConfigureReservedCustomHeaders(t *testing.T) {
+	defer resetGlobals()
+	SystemConfigPath = "filedoesnotexist"
+	HomeConfigPath = "filedoesnotexist"
+
+	for _, name := range []string{"Authorization", "x-sf-token"} {
+		raw := map[string]interface{}{
+			"auth_token":     "XXX",
+			"custom_headers": map[string]interface{}{name: "nope"},
+		}
+
+		rp := Provider()
+		diag := rp.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+		assert.True(t, diag.HasError(), "Expected %s to be rejected", name)
+	}
+}
+
+func TestHeadersTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "terraform", r.Header.Get("X-Request-Source"))
+		assert.Equal(t, "XXX", r.Header.Get("X-SF-Token"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headersTransport{
+		next:    http.DefaultTransport,
+		headers: map[string]string{"X-Request-Source": "terraform"},
+	}}
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+	req.Header.Set("X-SF-Token", "XXX")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header.Get("X-Request-Source"), "Expected the original request to be left untouched")
+}
+
+func TestRunAuthTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Uses a POSIX shell")
+	}
+
+	token, err := runAuthTokenCommand("printf '  XXX\\n'")
+	assert.NoError(t, err)
+	assert.Equal(t, "XXX", token)
+
+	_, err = runAuthTokenCommand("echo 'vault: permission denied' >&2; exit 2")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "vault: permission denied")
+
+	_, err = runAuthTokenCommand("true")
+	assert.EqualError(t, err, "auth_token_command returned an empty token")
+}
@@ -64,10 +64,18 @@ func alertMutingRuleResource() *schema.Resource {
 				ForceNew:    true,
 			},
 			"stop_time": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
-				Description: "stop time of an alert muting rule as a Unix timestamp, in seconds",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"duration"},
+				Description:   "stop time of an alert muting rule as a Unix timestamp, in seconds",
+			},
+			"duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"stop_time"},
+				ValidateFunc:  validatePositiveDuration,
+				Description:   "how long the rule mutes alerts, e.g. `2h`, counted from `start_time` or from the time of the apply if that is later. Used to compute `stop_time`",
 			},
 			// Because the API returns a different start time from that
 			// defined in the config file, we need another place to store
@@ -77,7 +85,19 @@ func alertMutingRuleResource() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "whether the rule is ACTIVE, SCHEDULED or EXPIRED at the last refresh",
+			},
+			"effective": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "whether the rule was muting alerts at the last refresh",
+			},
 		},
+		CustomizeDiff: alertMutingRuleStopTimeDiff,
+
 		Create: alertMutingRuleCreate,
 		Read:   alertMutingRuleRead,
 		Update: alertMutingRuleUpdate,
@@ -119,16 +139,66 @@ func getPayloadAlertMutingRule(d *schema.ResourceData) (*alertmuting.CreateUpdat
 		}
 	}
 
+	stopTime := int64(d.Get("stop_time").(int))
+	// The stop time is only computed when the duration is set or changed, so
+	// that later updates don't keep extending the rule
+	if duration, ok := d.GetOk("duration"); ok && d.HasChange("duration") {
+		dur, err := time.ParseDuration(duration.(string))
+		if err != nil {
+			return nil, err
+		}
+		stopTime = alertMutingStopTime(int64(d.Get("start_time").(int)), time.Now().Unix(), dur)
+	}
+
 	cuamrr := &alertmuting.CreateUpdateAlertMutingRuleRequest{
 		Description: d.Get("description").(string),
 		Filters:     filterList,
 		StartTime:   int64(d.Get("start_time").(int) * 1000),
-		StopTime:    int64(d.Get("stop_time").(int) * 1000),
+		StopTime:    stopTime * 1000,
 	}
 
 	return cuamrr, nil
 }
 
+/*
+The API starts rules in the past at the time of the call, so a duration is
+counted from whichever of the start time and now is later.
+*/
+func alertMutingStopTime(startTime int64, now int64, duration time.Duration) int64 {
+	if startTime < now {
+		startTime = now
+	}
+	return startTime + int64(duration/time.Second)
+}
+
+/*
+stop_time is computed at apply time from duration, and otherwise defaults to
+0, i.e. the rule never stops, when it isn't set.
+*/
+func alertMutingRuleStopTimeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("duration"); ok {
+		if d.HasChange("duration") {
+			return d.SetNewComputed("stop_time")
+		}
+		return nil
+	}
+	raw := d.GetRawConfig()
+	if raw.IsKnown() && !raw.IsNull() && raw.GetAttr("stop_time").IsNull() && d.Get("stop_time").(int) != 0 {
+		return d.SetNew("stop_time", 0)
+	}
+	return nil
+}
+
+func validatePositiveDuration(v interface{}, k string) (we []string, errors []error) {
+	dur, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %q is not a valid duration, e.g. 90m or 2h", k, v))
+	} else if dur < time.Second {
+		errors = append(errors, fmt.Errorf("%s: must be at least 1s, got %q", k, v))
+	}
+	return
+}
+
 func alertMutingRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
 	payload, err := getPayloadAlertMutingRule(d)
@@ -232,9 +302,32 @@ func alertMutingRuleAPIToTF(d *schema.ResourceData, amr *alertmuting.AlertMuting
 		}
 	}
 
+	status := alertMutingRuleStatus(amr.StartTime, amr.StopTime, time.Now().UnixNano()/int64(time.Millisecond))
+	if err := d.Set("status", status); err != nil {
+		return err
+	}
+	if err := d.Set("effective", status == "ACTIVE"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+/*
+Derives the status shown in the UI from the rule's times, in milliseconds. A
+stop time of 0 means the rule never stops.
+*/
+func alertMutingRuleStatus(startTime int64, stopTime int64, now int64) string {
+	switch {
+	case stopTime != 0 && stopTime <= now:
+		return "EXPIRED"
+	case startTime > now:
+		return "SCHEDULED"
+	default:
+		return "ACTIVE"
+	}
+}
+
 func alertMutingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
 	payload, err := getPayloadAlertMutingRule(d)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const newAlertMutingRuleConfig = `
@@ -139,3 +140,31 @@ func testAccAlertMutingRuleDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestAlertMutingStopTime(t *testing.T) {
+	now := int64(1700000000)
+	// Rules starting in the past start now
+	assert.Equal(t, now+7200, alertMutingStopTime(now-600, now, 2*time.Hour))
+	assert.Equal(t, now+3600+5400, alertMutingStopTime(now+3600, now, 90*time.Minute))
+}
+
+func TestValidatePositiveDuration(t *testing.T) {
+	for _, v := range []string{"2h", "90m", "1h30m", "1s"} {
+		_, errs := validatePositiveDuration(v, "duration")
+		assert.Empty(t, errs, v)
+	}
+	for _, v := range []string{"", "2", "two hours", "-1h", "0s", "500ms"} {
+		_, errs := validatePositiveDuration(v, "duration")
+		assert.NotEmpty(t, errs, v)
+	}
+}
+
+func TestAlertMutingRuleStatus(t *testing.T) {
+	now := int64(1700000000000)
+	assert.Equal(t, "SCHEDULED", alertMutingRuleStatus(now+1000, now+2000, now))
+	assert.Equal(t, "SCHEDULED", alertMutingRuleStatus(now+1000, 0, now))
+	assert.Equal(t, "ACTIVE", alertMutingRuleStatus(now-1000, now+1000, now))
+	assert.Equal(t, "ACTIVE", alertMutingRuleStatus(now-1000, 0, now))
+	assert.Equal(t, "EXPIRED", alertMutingRuleStatus(now-2000, now-1000, now))
+	assert.Equal(t, "EXPIRED", alertMutingRuleStatus(now-2000, now, now))
+}
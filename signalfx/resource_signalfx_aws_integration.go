@@ -44,9 +44,13 @@ func integrationAWSResource() *schema.Resource {
 				Description: "Whether the integration is enabled or not",
 			},
 			"auth_method": &schema.Schema{
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The mechanism used to authenticate with AWS. Use one of `signalfx_aws_external_integration` or `signalfx_aws_token_integration` to define this",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(integration.EXTERNAL_ID), string(integration.SECURITY_TOKEN),
+				}, false),
+				Description: "The mechanism used to authenticate with AWS, one of `ExternalId` (use with `signalfx_aws_external_integration`) or `SecurityToken` (use with `signalfx_aws_token_integration`). Inferred from the credentials if not set",
 			},
 			"custom_cloudwatch_namespaces": &schema.Schema{
 				Type: schema.TypeSet,
@@ -186,6 +190,11 @@ func integrationAWSResource() *schema.Resource {
 				Computed:    true,
 				Description: "Enables the use of Cloudwatch Metric Streams for metrics synchronization.",
 			},
+			"metric_streams_sync_state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the CloudWatch Metric Streams sync: `ENABLED`, `DISABLED` or `CANCELLING` while streams are being stopped. Empty if Metric Streams were never set up.",
+			},
 			"enable_logs_sync": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -243,6 +252,8 @@ func integrationAWSResource() *schema.Resource {
 		Update: integrationAWSUpdate,
 		Delete: integrationAWSDelete,
 		Exists: integrationAWSExists,
+
+		CustomizeDiff: validateAWSIntegrationAuthMethod,
 	}
 }
 
@@ -311,6 +322,9 @@ func awsIntegrationAPIToTF(d *schema.ResourceData, aws *integration.AwsCloudWatc
 	if err := d.Set("use_metric_streams_sync", aws.MetricStreamsSyncState == "ENABLED"); err != nil {
 		return err
 	}
+	if err := d.Set("metric_streams_sync_state", aws.MetricStreamsSyncState); err != nil {
+		return err
+	}
 	if err := d.Set("enable_logs_sync", aws.LogsSyncState == "ENABLED"); err != nil {
 		return err
 	}
@@ -538,6 +552,44 @@ func getPayloadAWSIntegration(d *schema.ResourceData) (*integration.AwsCloudWatc
 	return aws, nil
 }
 
+/*
+Checks an explicitly requested auth method at plan time. auth_method is also
+computed from the credentials, so only check it when it's set in the
+configuration, and only once the credentials are known.
+*/
+func validateAWSIntegrationAuthMethod(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	raw := d.GetRawConfig()
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil
+	}
+	authMethod := raw.GetAttr("auth_method")
+	if !authMethod.IsKnown() || authMethod.IsNull() {
+		return nil
+	}
+	if !d.NewValueKnown("external_id") || !d.NewValueKnown("token") {
+		return nil
+	}
+	return validateAWSAuthMethod(authMethod.AsString(), d.Get("external_id").(string), d.Get("token").(string))
+}
+
+/*
+Checks that the credentials of the linked external or token integration match
+the auth method, as mixing them up is easy when onboarding.
+*/
+func validateAWSAuthMethod(authMethod string, externalID string, token string) error {
+	switch integration.AwsAuthMethod(authMethod) {
+	case integration.EXTERNAL_ID:
+		if externalID == "" {
+			return fmt.Errorf("auth_method %q requires `external_id` and `role_arn` from a `signalfx_aws_external_integration`", authMethod)
+		}
+	case integration.SECURITY_TOKEN:
+		if token == "" {
+			return fmt.Errorf("auth_method %q requires `token` and `key` from a `signalfx_aws_token_integration`", authMethod)
+		}
+	}
+	return nil
+}
+
 func getCustomNamespaceRules(tfRules []interface{}) []*integration.AwsCustomNameSpaceSyncRule {
 	rules := make([]*integration.AwsCustomNameSpaceSyncRule, len(tfRules))
 	for i, r := range tfRules {
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -373,6 +374,59 @@ func TestValidateFilterAction(t *testing.T) {
 	assert.Equal(t, 1, len(errors), "Errors for invalid value")
 }
 
+func TestValidateAWSAuthMethod(t *testing.T) {
+	assert.NoError(t, validateAWSAuthMethod("", "", "XXX"), "No error when auth_method is inferred")
+	assert.NoError(t, validateAWSAuthMethod("ExternalId", "XXX", ""), "No error for matching external ID")
+	assert.NoError(t, validateAWSAuthMethod("SecurityToken", "", "XXX"), "No error for matching token")
+
+	assert.Error(t, validateAWSAuthMethod("ExternalId", "", "XXX"), "Error for token with ExternalId")
+	assert.Error(t, validateAWSAuthMethod("SecurityToken", "XXX", ""), "Error for external ID with SecurityToken")
+}
+
+func TestAWSIntegrationAuthMethodDiff(t *testing.T) {
+	r := integrationAWSResource()
+	state := &terraform.InstanceState{
+		ID: "XXX",
+		Attributes: map[string]string{
+			"integration_id": "XXX",
+			"enabled":        "true",
+			"regions.#":      "1",
+			"regions.0":      "us-east-1",
+			"auth_method":    "ExternalId",
+			"external_id":    "YYY",
+			"role_arn":       "arn:aws:iam::123456789012:role/splunk",
+		},
+	}
+	// The raw config comes along with the state, as the gRPC server sets it
+	newConfig := func(attrs map[string]cty.Value) *terraform.ResourceConfig {
+		block := r.CoreConfigSchema()
+		vals := map[string]cty.Value{}
+		for name, ty := range block.ImpliedType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		for name, v := range attrs {
+			vals[name] = v
+		}
+		state.RawConfig = cty.ObjectVal(vals)
+		return terraform.NewResourceConfigShimmed(state.RawConfig, block)
+	}
+	attrs := map[string]cty.Value{
+		"integration_id": cty.StringVal("XXX"),
+		"enabled":        cty.True,
+		"regions":        cty.SetVal([]cty.Value{cty.StringVal("us-east-1")}),
+		"token":          cty.StringVal("ZZZ"),
+		"key":            cty.StringVal("secret"),
+	}
+
+	// auth_method was only ever computed, so switching credentials is fine
+	_, err := r.Diff(context.Background(), state, newConfig(attrs), nil)
+	assert.NoError(t, err)
+
+	attrs["auth_method"] = cty.StringVal("ExternalId")
+	_, err = r.Diff(context.Background(), state, newConfig(attrs), nil)
+	assert.Error(t, err)
+}
+
 func skipTestWhenAWSCredentialsAreMissing(t *testing.T, awsAccessKeyID, awsSecretAccessKey string) func() (bool, error) {
 	return func() (bool, error) {
 		if awsAccessKeyID != "" && awsSecretAccessKey != "" {
@@ -98,8 +98,10 @@ func integrationAzureResource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"filter_source": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "Filter expression selecting the Azure resources to import, e.g. `filter('azure_tag_env', 'prod')`",
 						},
 					},
 				},
@@ -244,17 +246,16 @@ func azureIntegrationAPIToTF(d *schema.ResourceData, azure *integration.AzureInt
 			return err
 		}
 	}
-	if len(azure.ResourceFilterRules) > 0 {
-		var rules []map[string]interface{}
-		for _, v := range azure.ResourceFilterRules {
-			filter_source := v.Filter.Source
-			rules = append(rules, map[string]interface{}{
-				"filter_source": filter_source,
-			})
-		}
-		if err := d.Set("resource_filter_rules", rules); err != nil {
-			return err
-		}
+	// Always set the rules so that ones removed outside Terraform show up as a diff
+	rules := make([]map[string]interface{}, 0, len(azure.ResourceFilterRules))
+	for _, v := range azure.ResourceFilterRules {
+		filter_source := v.Filter.Source
+		rules = append(rules, map[string]interface{}{
+			"filter_source": filter_source,
+		})
+	}
+	if err := d.Set("resource_filter_rules", rules); err != nil {
+		return err
 	}
 
 	return nil
@@ -37,7 +37,7 @@ func dashboardResource() *schema.Resource {
 				Description: "Description of the dashboard (Optional)",
 			},
 			"tags": &schema.Schema{
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Tags of the dashboard",
@@ -449,8 +449,15 @@ func dashboardResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the dashboard",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the dashboard was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: dashboardCreate,
 		Read:   dashboardRead,
 		Update: dashboardUpdate,
@@ -493,8 +500,7 @@ func getPayloadDashboard(d *schema.ResourceData) (*dashboard.CreateUpdateDashboa
 
 	if val, ok := d.GetOk("tags"); ok {
 		var tags []string
-		tfValues := val.([]interface{})
-		for _, v := range tfValues {
+		for _, v := range val.(*schema.Set).List() {
 			tags = append(tags, v.(string))
 		}
 		cudr.Tags = tags
@@ -869,6 +875,9 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 	debugOutput, _ := json.Marshal(dash)
 	log.Printf("[DEBUG] SignalFx: Got Dashboard to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", dash.LastUpdated); err != nil {
+		return err
+	}
 	if err := d.Set("name", dash.Name); err != nil {
 		return err
 	}
@@ -878,6 +887,9 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 	if err := d.Set("description", dash.Description); err != nil {
 		return err
 	}
+	if err := d.Set("tags", dash.Tags); err != nil {
+		return err
+	}
 	if err := d.Set("charts_resolution", strings.ToLower(string(*dash.ChartDensity))); err != nil {
 		return err
 	}
@@ -1040,7 +1052,7 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 			if v.EventColorIndex != nil {
 				colorName, err := getNameFromPaletteColorsByIndex(int(*v.EventColorIndex))
 				if err != nil {
-					return fmt.Errorf("Unknown event overlay color: %d", v.EventColorIndex)
+					return fmt.Errorf("Unknown event overlay color: %d", *v.EventColorIndex)
 				}
 				evOverlay["color"] = colorName
 			}
@@ -1117,6 +1129,10 @@ func dashboardUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Dashboard Payload: %s", string(debugOutput))
 
+	if err := checkDashboardUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	dash, err := config.Client.UpdateDashboard(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
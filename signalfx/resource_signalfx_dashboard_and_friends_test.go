@@ -111,6 +111,12 @@ resource "signalfx_dashboard" "mydashboard0" {
         values = ["uswest-1"]
         negated = true
       }
+    }
+		event_overlay {
+      label = "deploys"
+      color = "green"
+      signal = "deploy"
+      type = "eventTimeSeries"
     }
 		selected_event_overlay {
       signal = "overlabel"
@@ -392,7 +398,7 @@ func TestAccCreateUpdateDashboardGroup(t *testing.T) {
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "variable.0.values.#", "1"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "variable.0.values.0", "uswest-1"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "variable.0.values_suggested.#", "1"),
-					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.#", "1"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.#", "2"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.color", "lilac"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.label", "a event overlabel"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.line", "true"),
@@ -403,6 +409,11 @@ func TestAccCreateUpdateDashboardGroup(t *testing.T) {
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.source.0.values.#", "1"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.source.0.values.0", "uswest-1"),
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.0.type", "detectorEvents"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.1.color", "green"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.1.label", "deploys"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.1.line", "false"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.1.signal", "deploy"),
+					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "event_overlay.1.type", "eventTimeSeries"),
 
 					// Selected Event Overlays
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "selected_event_overlay.#", "1"),
@@ -419,6 +430,9 @@ func TestAccCreateUpdateDashboardGroup(t *testing.T) {
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboard0", "chart.#", "6"),
 					// We're not testing each chart because they aren't stable, TODO?
 
+					// Data Links
+					resource.TestCheckResourceAttrPair("signalfx_data_link.my_data_link_dash", "context_dashboard_id", "signalfx_dashboard.mydashboard0", "id"),
+
 					// Dashboard Group
 					resource.TestCheckResourceAttr("signalfx_dashboard_group.mydashboardgroup0", "description", "Cool dashboard group"),
 					resource.TestCheckResourceAttr("signalfx_dashboard_group.mydashboardgroup0", "name", "My team dashboard group"),
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 const newDashboardGroupConfig = `
@@ -170,3 +172,23 @@ func TestAccCreateDashboardGroupsWithDashboardAndDashboardWithNameMirror(t *test
 		},
 	})
 }
+
+func TestDashboardGroupPermissionsOmittedNotSent(t *testing.T) {
+	// Without permissions the server-side ACL must be left untouched
+	d := schema.TestResourceDataRaw(t, dashboardGroupResource().Schema, map[string]interface{}{
+		"name": "My group",
+	})
+	assert.Nil(t, getPayloadDashboardGroup(d).Permissions)
+
+	d = schema.TestResourceDataRaw(t, dashboardGroupResource().Schema, map[string]interface{}{
+		"name": "My group",
+		"permissions": []interface{}{map[string]interface{}{
+			"principal_id":   "abc123",
+			"principal_type": "USER",
+			"actions":        []interface{}{"READ"},
+		}},
+	})
+	acl := getPayloadDashboardGroup(d).Permissions.Acl
+	assert.Len(t, acl, 1)
+	assert.Equal(t, "USER", acl[0].PrincipalType)
+}
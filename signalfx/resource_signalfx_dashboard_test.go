@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/dashboard"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,10 +89,125 @@ func TestDashboardTagsApplied(t *testing.T) {
 				Config: fmt.Sprintf(widthTestDashConfig, 1),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboardX0", "tags.#", "2"),
-					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboardX0", "tags.0", "cool tag"),
-					resource.TestCheckResourceAttr("signalfx_dashboard.mydashboardX0", "tags.1", "not so cool tag"),
+					resource.TestCheckTypeSetElemAttr("signalfx_dashboard.mydashboardX0", "tags.*", "cool tag"),
+					resource.TestCheckTypeSetElemAttr("signalfx_dashboard.mydashboardX0", "tags.*", "not so cool tag"),
 				),
 			},
 		},
 	})
 }
+
+func TestDashboardChartOrderIgnored(t *testing.T) {
+	charts := []*dashboard.DashboardChart{
+		{ChartId: "AAA", Row: 0, Column: 0, Height: 1, Width: 6},
+		{ChartId: "BBB", Row: 0, Column: 6, Height: 1, Width: 6},
+		{ChartId: "CCC", Row: 1, Column: 0, Height: 2, Width: 12},
+	}
+	density := dashboard.DEFAULT
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]interface{}{})
+	err := dashboardAPIToTF(d, &dashboard.Dashboard{Charts: charts, ChartDensity: &density})
+	assert.NoError(t, err)
+	original := d.Get("chart").(*schema.Set)
+
+	// The server may return the charts in any order
+	reordered := []*dashboard.DashboardChart{charts[2], charts[0], charts[1]}
+	err = dashboardAPIToTF(d, &dashboard.Dashboard{Charts: reordered, ChartDensity: &density})
+	assert.NoError(t, err)
+	assert.True(t, original.Equal(d.Get("chart").(*schema.Set)), "Expected reordered charts to produce the same state")
+
+	// Removing a chart is still detected
+	err = dashboardAPIToTF(d, &dashboard.Dashboard{Charts: charts[:2], ChartDensity: &density})
+	assert.NoError(t, err)
+	assert.False(t, original.Equal(d.Get("chart").(*schema.Set)), "Expected removed chart to change the state")
+}
+
+func TestDashboardTagOrderIgnored(t *testing.T) {
+	density := dashboard.DEFAULT
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]interface{}{})
+	err := dashboardAPIToTF(d, &dashboard.Dashboard{Tags: []string{"team-a", "prod"}, ChartDensity: &density})
+	assert.NoError(t, err)
+	original := d.Get("tags").(*schema.Set)
+
+	err = dashboardAPIToTF(d, &dashboard.Dashboard{Tags: []string{"prod", "team-a"}, ChartDensity: &density})
+	assert.NoError(t, err)
+	assert.True(t, original.Equal(d.Get("tags").(*schema.Set)), "Expected reordered tags to produce the same state")
+
+	err = dashboardAPIToTF(d, &dashboard.Dashboard{Tags: []string{"prod"}, ChartDensity: &density})
+	assert.NoError(t, err)
+	assert.False(t, original.Equal(d.Get("tags").(*schema.Set)), "Expected removed tag to change the state")
+}
+
+/*
+Dashboards and detectors are updated in place with PUT, so no argument should
+force a destroy and create of production dashboards or detectors.
+*/
+func assertNoForceNew(t *testing.T, path string, s map[string]*schema.Schema) {
+	for k, sch := range s {
+		assert.False(t, sch.ForceNew, "Expected %s%s to be updated in place", path, k)
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			assertNoForceNew(t, path+k+".", elem.Schema)
+		}
+	}
+}
+
+func TestDashboardUpdatesInPlace(t *testing.T) {
+	assertNoForceNew(t, "", dashboardResource().Schema)
+}
+
+func TestDashboardVariableRoundTrip(t *testing.T) {
+	density := dashboard.DEFAULT
+	variable := &dashboard.ChartsWebUiFilter{
+		Property:             "env",
+		Alias:                "Environment",
+		Value:                []string{"prod"},
+		PreferredSuggestions: []string{"prod", "staging"},
+		Restricted:           true,
+		ApplyIfExists:        true,
+	}
+
+	// An imported dashboard must produce the same payload it was read from
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]interface{}{})
+	err := dashboardAPIToTF(d, &dashboard.Dashboard{
+		Filters:      &dashboard.ChartsFilters{Variables: []*dashboard.ChartsWebUiFilter{variable}},
+		ChartDensity: &density,
+	})
+	assert.NoError(t, err)
+	vars := d.Get("variable").(*schema.Set).List()
+	assert.Len(t, vars, 1)
+	assert.Equal(t, true, vars[0].(map[string]interface{})["restricted_suggestions"])
+	assert.Equal(t, true, vars[0].(map[string]interface{})["apply_if_exist"])
+
+	payload, err := getPayloadDashboard(d)
+	assert.NoError(t, err)
+	assert.Len(t, payload.Filters.Variables, 1)
+	got := payload.Filters.Variables[0]
+	assert.True(t, got.Restricted)
+	assert.True(t, got.ApplyIfExists)
+	assert.ElementsMatch(t, variable.PreferredSuggestions, got.PreferredSuggestions)
+	assert.ElementsMatch(t, variable.Value, got.Value)
+}
+
+func TestDashboardPermissionsOmittedNotSent(t *testing.T) {
+	// Without a permissions block the server-side ACL must be left untouched
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]interface{}{})
+	payload, err := getPayloadDashboard(d)
+	assert.NoError(t, err)
+	assert.Nil(t, payload.Permissions)
+
+	d = schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]interface{}{
+		"permissions": []interface{}{map[string]interface{}{
+			"acl": []interface{}{map[string]interface{}{
+				"principal_id":   "abc123",
+				"principal_type": "TEAM",
+				"actions":        []interface{}{"READ", "WRITE"},
+			}},
+		}},
+	})
+	payload, err = getPayloadDashboard(d)
+	assert.NoError(t, err)
+	assert.Len(t, payload.Permissions.Acl, 1)
+	assert.Equal(t, "abc123", payload.Permissions.Acl[0].PrincipalId)
+	assert.ElementsMatch(t, []string{"READ", "WRITE"}, payload.Permissions.Acl[0].Actions)
+}
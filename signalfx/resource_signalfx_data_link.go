@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -58,6 +59,15 @@ func dataLinkResource() *schema.Resource {
 							Required:    true,
 							Description: "User-assigned target name. Use this value to differentiate between the link targets for a data link object.",
 						},
+						"property_key_mapping": &schema.Schema{
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Description:  "Maps the property of the data point the link is opened from to the name of a variable of the target dashboard, when the names are different",
+							ValidateFunc: validatePropertyKeyMapping,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -82,9 +92,10 @@ func dataLinkResource() *schema.Resource {
 							}, false),
 						},
 						"url": &schema.Schema{
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "URL string for a Splunk instance or external system data link target.",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "URL string for a Splunk instance or external system data link target.",
+							ValidateFunc: validateDataLinkURLTemplate,
 						},
 						"property_key_mapping": &schema.Schema{
 							Type:        schema.TypeMap,
@@ -178,6 +189,13 @@ func getPayloadDataLink(d *schema.ResourceData) (*datalink.CreateUpdateDataLinkR
 			if val, ok := tfLink["dashboard_name"]; ok {
 				dl.DashboardName = val.(string)
 			}
+			if v, ok := tfLink["property_key_mapping"]; ok {
+				pkMap := map[string]string{}
+				for key, value := range v.(map[string]interface{}) {
+					pkMap[key] = value.(string)
+				}
+				dl.PropertyKeyMapping = pkMap
+			}
 			dataLink.Targets = append(dataLink.Targets, dl)
 		}
 	}
@@ -292,10 +310,11 @@ func dataLinkAPIToTF(d *schema.ResourceData, dl *datalink.DataLink) error {
 		switch t.Type {
 		case datalink.INTERNAL_LINK:
 			tfTarget := map[string]interface{}{
-				"name":               t.Name,
-				"dashboard_group_id": t.DashboardGroupId,
-				"dashboard_id":       t.DashboardId,
-				"is_default":         t.IsDefault,
+				"name":                 t.Name,
+				"dashboard_group_id":   t.DashboardGroupId,
+				"dashboard_id":         t.DashboardId,
+				"is_default":           t.IsDefault,
+				"property_key_mapping": t.PropertyKeyMapping,
 			}
 			internalLinks = append(internalLinks, tfTarget)
 		case datalink.EXTERNAL_LINK:
@@ -382,3 +401,45 @@ func dataLinkExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	}
 	return true, nil
 }
+
+/*
+Keys are properties of the source data point and values are variable names of
+the target dashboard, neither of which can be empty or contain whitespace.
+*/
+func validatePropertyKeyMapping(v interface{}, k string) (we []string, errors []error) {
+	for key, value := range v.(map[string]interface{}) {
+		name, _ := value.(string)
+		if key == "" || strings.ContainsAny(key, " \t\n") {
+			errors = append(errors, fmt.Errorf("%s: %q is not a valid property name", k, key))
+		}
+		if name == "" || strings.ContainsAny(name, " \t\n") {
+			errors = append(errors, fmt.Errorf("%s: %q is not a valid variable name for property %q", k, name, key))
+		}
+	}
+	return
+}
+
+var dataLinkTemplateVariables = []string{"end_time", "key", "properties", "start_time", "value"}
+
+var dataLinkTemplateRegexp = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+/*
+External URLs can reference the context of the data point with template
+variables such as {{start_time}}, which the API substitutes when the link is
+opened. Reject unknown ones instead of leaving them in the URL verbatim.
+*/
+func validateDataLinkURLTemplate(v interface{}, k string) (we []string, errors []error) {
+	for _, match := range dataLinkTemplateRegexp.FindAllStringSubmatch(v.(string), -1) {
+		found := false
+		for _, name := range dataLinkTemplateVariables {
+			if match[1] == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errors = append(errors, fmt.Errorf("%s: unknown template variable %s, must be one of {{%s}}", k, match[0], strings.Join(dataLinkTemplateVariables, "}}, {{")))
+		}
+	}
+	return
+}
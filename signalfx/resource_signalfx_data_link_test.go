@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const newDataLinkConfig = `
@@ -96,6 +97,7 @@ func TestAccCreateUpdateDataLink(t *testing.T) {
 					testAccCheckDataLinkResourceExists,
 					resource.TestCheckResourceAttr("signalfx_data_link.big_test_data_link", "property_name", "pname"),
 					resource.TestCheckResourceAttr("signalfx_data_link.big_test_data_link", "property_value", "pvalue"),
+					resource.TestCheckResourceAttr("signalfx_data_link.big_test_data_link", "context_dashboard_id", ""),
 				),
 			},
 			{
@@ -194,3 +196,25 @@ func testAccDataLinkDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestValidatePropertyKeyMapping(t *testing.T) {
+	_, errs := validatePropertyKeyMapping(map[string]interface{}{"service.name": "service", "host": "hostname"}, "property_key_mapping")
+	assert.Empty(t, errs)
+
+	_, errs = validatePropertyKeyMapping(map[string]interface{}{"service.name": ""}, "property_key_mapping")
+	assert.Len(t, errs, 1)
+
+	_, errs = validatePropertyKeyMapping(map[string]interface{}{"service name": "my service"}, "property_key_mapping")
+	assert.Len(t, errs, 2)
+}
+
+func TestValidateDataLinkURLTemplate(t *testing.T) {
+	_, errs := validateDataLinkURLTemplate("https://grafana.example.com/d/abc?var-host={{value}}&from={{start_time}}&to={{ end_time }}", "url")
+	assert.Empty(t, errs)
+
+	_, errs = validateDataLinkURLTemplate("https://www.example.com", "url")
+	assert.Empty(t, errs)
+
+	_, errs = validateDataLinkURLTemplate("https://www.example.com/?from={{startTime}}", "url")
+	assert.Len(t, errs, 1)
+}
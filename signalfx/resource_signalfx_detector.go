@@ -96,10 +96,11 @@ func detectorResource() *schema.Resource {
 				Description: "Description of the detector",
 			},
 			"timezone": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "UTC",
-				Description: "The property value is a string that denotes the geographic region associated with the time zone, (e.g. Australia/Sydney)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimezone,
+				Description:  "The property value is a string that denotes the geographic region associated with the time zone, (e.g. Australia/Sydney)",
 			},
 			"max_delay": {
 				Type:         schema.TypeInt,
@@ -158,6 +159,12 @@ func detectorResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Tags associated with the detector",
 			},
+			"custom_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "User-defined metadata attached to the detector and included in the events it fires",
+			},
 			"teams": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -238,6 +245,11 @@ func detectorResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "URL of the detector",
+			},
+			"last_updated": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the detector was last updated, in milliseconds since epoch. Used to detect changes made outside of Terraform before updating",
 			}},
 
 		SchemaVersion: 1,
@@ -249,7 +261,10 @@ func detectorResource() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: customdiff.If(validateProgramTextCondition, validateProgramText),
+		CustomizeDiff: customdiff.All(
+			customdiff.If(validateProgramTextCondition, validateProgramText),
+			lastUpdatedComputed,
+		),
 
 		Create: detectorCreate,
 		Read:   detectorRead,
@@ -287,10 +302,31 @@ func timeRangeStateUpgradeV0(ctx context.Context, rawState map[string]interface{
 	return rawState, nil
 }
 
+/*
+Detectors whose configuration leaves out min_delay get the provider's
+default_min_delay. An explicit min_delay, including 0, is always kept.
+*/
+func getDetectorMinDelay(d *schema.ResourceData, defaultMinDelay int) int {
+	minDelay := d.Get("min_delay").(int)
+	if defaultMinDelay <= 0 {
+		return minDelay
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		if raw.GetAttr("min_delay").IsNull() {
+			return defaultMinDelay
+		}
+		return minDelay
+	}
+	if minDelay == 0 {
+		return defaultMinDelay
+	}
+	return minDelay
+}
+
 /*
 Use Resource object to construct json payload in order to create a detector
 */
-func getPayloadDetector(d *schema.ResourceData) (*detector.CreateUpdateDetectorRequest, error) {
+func getPayloadDetector(d *schema.ResourceData, defaultMinDelay int) (*detector.CreateUpdateDetectorRequest, error) {
 
 	tfRules := d.Get("rule").(*schema.Set).List()
 	rulesList := make([]*detector.Rule, len(tfRules))
@@ -304,7 +340,7 @@ func getPayloadDetector(d *schema.ResourceData) (*detector.CreateUpdateDetectorR
 	}
 
 	maxDelay := int32(d.Get("max_delay").(int) * 1000)
-	minDelay := int32(d.Get("min_delay").(int) * 1000)
+	minDelay := int32(getDetectorMinDelay(d, defaultMinDelay) * 1000)
 
 	var tags []string
 	if val, ok := d.GetOk("tags"); ok {
@@ -343,6 +379,14 @@ func getPayloadDetector(d *schema.ResourceData) (*detector.CreateUpdateDetectorR
 		cudr.AuthorizedWriters.Users = users
 	}
 
+	if val, ok := d.GetOk("custom_properties"); ok {
+		props, err := json.Marshal(val.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		cudr.CustomProperties = string(props)
+	}
+
 	cudr.VisualizationOptions = getVisualizationOptionsDetector(d)
 
 	if val, ok := d.GetOk("teams"); ok {
@@ -408,6 +452,47 @@ func getDetectorRule(tfRule map[string]interface{}) (*detector.Rule, error) {
 	return rule, nil
 }
 
+/*
+The API accepts custom properties as a JSON encoded string but may return them
+either as that string or as the decoded object, so handle both.
+*/
+func flattenDetectorCustomProperties(props *interface{}) (map[string]interface{}, error) {
+	if props == nil || *props == nil {
+		return nil, nil
+	}
+
+	var decoded map[string]interface{}
+	switch v := (*props).(type) {
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v), &decoded); err != nil {
+			return nil, fmt.Errorf("Unable to decode detector custom properties %q: %s", v, err)
+		}
+	case map[string]interface{}:
+		decoded = v
+	default:
+		return nil, fmt.Errorf("Unexpected type %T for detector custom properties", v)
+	}
+
+	tfProps := make(map[string]interface{}, len(decoded))
+	for k, v := range decoded {
+		if str, ok := v.(string); ok {
+			tfProps[k] = str
+		} else {
+			// Non string values can only have been set outside of terraform,
+			// so keep their JSON representation
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			tfProps[k] = string(b)
+		}
+	}
+	return tfProps, nil
+}
+
 func getVisualizationOptionsDetector(d *schema.ResourceData) *detector.Visualization {
 	viz := detector.Visualization{}
 
@@ -440,38 +525,16 @@ func getVisualizationOptionsDetector(d *schema.ResourceData) *detector.Visualiza
 		viz.Time = tr
 	}
 
-	if vizOptions := getPerSignalDetectorVizOptions(d); len(vizOptions) > 0 {
+	if vizOptions := buildDetectorVizOptions(d.Get("viz_options").(*schema.Set).List()); len(vizOptions) > 0 {
 		viz.PublishLabelOptions = vizOptions
 	}
 
 	return &viz
 }
 
-func detectorPublishLabelOptionsToMap(options *detector.PublishLabelOptions) (map[string]interface{}, error) {
-	color := ""
-	if options.PaletteIndex != nil {
-		// We might not have a color, so tread lightly
-		c, err := getNameFromPaletteColorsByIndex(int(*options.PaletteIndex))
-		if err != nil {
-			return map[string]interface{}{}, err
-		}
-		// Ok, we can set the color now
-		color = c
-	}
-
-	return map[string]interface{}{
-		"label":        options.Label,
-		"display_name": options.DisplayName,
-		"color":        color,
-		"value_unit":   options.ValueUnit,
-		"value_suffix": options.ValueSuffix,
-		"value_prefix": options.ValuePrefix,
-	}, nil
-}
-
 func detectorCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
-	payload, err := getPayloadDetector(d)
+	payload, err := getPayloadDetector(d, config.DefaultMinDelay)
 	if err != nil {
 		return fmt.Errorf("Failed creating json payload: %s", err.Error())
 	}
@@ -526,10 +589,10 @@ func detectorRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return detectorAPIToTF(d, det)
+	return detectorAPIToTF(d, det, config.DefaultMinDelay)
 }
 
-func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
+func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector, defaultMinDelay int) error {
 	debugOutput, _ := json.Marshal(det)
 	log.Printf("[DEBUG] SignalFx: Got Detector to enState: %s", string(debugOutput))
 
@@ -553,19 +616,35 @@ func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
 		}
 	}
 	if det.MinDelay != nil {
-		if err := d.Set("min_delay", *det.MinDelay/1000); err != nil {
+		minDelay := int(*det.MinDelay / 1000)
+		// Detectors that don't set a min_delay get the provider's default,
+		// which shouldn't show up as a diff
+		if defaultMinDelay > 0 && minDelay == defaultMinDelay && d.Get("min_delay").(int) == 0 {
+			minDelay = 0
+		}
+		if err := d.Set("min_delay", minDelay); err != nil {
 			return err
 		}
 	}
 	if err := d.Set("label_resolutions", det.LabelResolutions); err != nil {
 		return err
 	}
+	if err := d.Set("last_updated", det.LastUpdated); err != nil {
+		return err
+	}
 	if err := d.Set("tags", det.Tags); err != nil {
 		return err
 	}
 	if err := d.Set("teams", det.Teams); err != nil {
 		return err
 	}
+	customProperties, err := flattenDetectorCustomProperties(det.CustomProperties)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("custom_properties", customProperties); err != nil {
+		return err
+	}
 
 	if det.AuthorizedWriters != nil {
 		aw := det.AuthorizedWriters
@@ -621,13 +700,9 @@ func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
 		}
 
 		if len(viz.PublishLabelOptions) > 0 {
-			plos := make([]map[string]interface{}, len(viz.PublishLabelOptions))
-			for i, plo := range viz.PublishLabelOptions {
-				no, err := detectorPublishLabelOptionsToMap(plo)
-				if err != nil {
-					return err
-				}
-				plos[i] = no
+			plos, err := flattenDetectorVizOptions(viz.PublishLabelOptions)
+			if err != nil {
+				return err
 			}
 			if err := d.Set("viz_options", plos); err != nil {
 				return err
@@ -675,11 +750,15 @@ func getTfDetectorRule(r *detector.Rule) (map[string]interface{}, error) {
 
 func detectorUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
-	payload, err := getPayloadDetector(d)
+	payload, err := getPayloadDetector(d, config.DefaultMinDelay)
 	if err != nil {
 		return fmt.Errorf("Failed creating json payload: %s", err.Error())
 	}
 
+	if err := checkDetectorUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Detector Payload: %s", string(debugOutput))
 
@@ -698,7 +777,7 @@ func detectorUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(det.Id)
 
-	return detectorAPIToTF(d, det)
+	return detectorAPIToTF(d, det, config.DefaultMinDelay)
 }
 
 func detectorDelete(d *schema.ResourceData, meta interface{}) error {
@@ -707,38 +786,6 @@ func detectorDelete(d *schema.ResourceData, meta interface{}) error {
 	return config.Client.DeleteDetector(context.TODO(), d.Id())
 }
 
-func getPerSignalDetectorVizOptions(d *schema.ResourceData) []*detector.PublishLabelOptions {
-	viz := d.Get("viz_options").(*schema.Set).List()
-	vizList := make([]*detector.PublishLabelOptions, len(viz))
-	for i, v := range viz {
-		v := v.(map[string]interface{})
-		item := &detector.PublishLabelOptions{
-			Label: v["label"].(string),
-		}
-		if val, ok := v["display_name"].(string); ok && val != "" {
-			item.DisplayName = val
-		}
-		if val, ok := v["color"].(string); ok {
-			if elem, ok := PaletteColors[val]; ok {
-				i := int32(elem)
-				item.PaletteIndex = &i
-			}
-		}
-		if val, ok := v["value_unit"].(string); ok && val != "" {
-			item.ValueUnit = val
-		}
-		if val, ok := v["value_suffix"].(string); ok && val != "" {
-			item.ValueSuffix = val
-		}
-		if val, ok := v["value_prefix"].(string); ok && val != "" {
-			item.ValuePrefix = val
-		}
-
-		vizList[i] = item
-	}
-	return vizList
-}
-
 /*
 Hashing function for rule substructure of the detector resource, used in determining state changes.
 */
@@ -859,16 +906,19 @@ func validateProgramText(ctx context.Context, d *schema.ResourceDiff, meta inter
 
 	config := meta.(*signalfxConfig)
 
-	err := config.Client.ValidateDetector(context.Background(), &detector.ValidateDetectorRequestModel{
-		Name:        d.Get("name").(string),
-		ProgramText: d.Get("program_text").(string),
-		Rules:       rulesList,
-	})
-	if err != nil {
-		return err
-	}
+	return validateSignalflowProgram(ctx, config, d.Get("name").(string), d.Get("program_text").(string), rulesList)
+}
 
-	return nil
+/*
+Validates a SignalFlow program using the detector validation endpoint, which
+compiles the program and reports unknown functions or syntax errors.
+*/
+func validateSignalflowProgram(ctx context.Context, config *signalfxConfig, name string, programText string, rules []*detector.Rule) error {
+	return config.Client.ValidateDetector(ctx, &detector.ValidateDetectorRequestModel{
+		Name:        name,
+		ProgramText: programText,
+		Rules:       rules,
+	})
 }
 
 // String hashes a string to a unique hashcode.
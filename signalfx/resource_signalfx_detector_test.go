@@ -2,14 +2,21 @@ package signalfx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"regexp"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sfx "github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/detector"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -191,6 +198,148 @@ resource "signalfx_detector" "high_cpu_utilization" {
 }
 `
 
+func TestFlattenDetectorCustomProperties(t *testing.T) {
+	var encoded interface{} = `{"team":"infra","env":"prod"}`
+	props, err := flattenDetectorCustomProperties(&encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"team": "infra", "env": "prod"}, props)
+
+	var decoded interface{} = map[string]interface{}{"team": "infra", "priority": float64(1)}
+	props, err = flattenDetectorCustomProperties(&decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"team": "infra", "priority": "1"}, props)
+
+	props, err = flattenDetectorCustomProperties(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, props)
+
+	var invalid interface{} = "not json"
+	_, err = flattenDetectorCustomProperties(&invalid)
+	assert.Error(t, err)
+}
+
+func TestDetectorDefaultMinDelay(t *testing.T) {
+	minDelay := int32(30000)
+	det := &detector.Detector{Name: "Default min delay", MinDelay: &minDelay}
+
+	// The provider default applied to a detector without min_delay is not a diff
+	d := schema.TestResourceDataRaw(t, detectorResource().Schema, map[string]interface{}{})
+	assert.NoError(t, detectorAPIToTF(d, det, 30))
+	assert.Equal(t, 0, d.Get("min_delay"))
+
+	// Without a provider default the API value is kept
+	d = schema.TestResourceDataRaw(t, detectorResource().Schema, map[string]interface{}{})
+	assert.NoError(t, detectorAPIToTF(d, det, 0))
+	assert.Equal(t, 30, d.Get("min_delay"))
+
+	// User values win over the provider default
+	d = schema.TestResourceDataRaw(t, detectorResource().Schema, map[string]interface{}{"min_delay": 60})
+	minDelay = 60000
+	assert.NoError(t, detectorAPIToTF(d, det, 30))
+	assert.Equal(t, 60, d.Get("min_delay"))
+}
+
+func TestGetDetectorMinDelay(t *testing.T) {
+	r := detectorResource()
+	data := func(minDelay cty.Value) *schema.ResourceData {
+		vals := map[string]cty.Value{}
+		for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		vals["min_delay"] = minDelay
+		raw := cty.ObjectVal(vals)
+		attrs := map[string]string{}
+		if !minDelay.IsNull() {
+			attrs["min_delay"] = minDelay.AsBigFloat().String()
+		}
+		d, err := schema.InternalMap(r.Schema).Data(&terraform.InstanceState{Attributes: attrs, RawConfig: raw}, nil)
+		assert.NoError(t, err)
+		return d
+	}
+
+	assert.Equal(t, 30, getDetectorMinDelay(data(cty.NullVal(cty.Number)), 30))
+	assert.Equal(t, 0, getDetectorMinDelay(data(cty.NullVal(cty.Number)), 0))
+	// An explicit 0 isn't replaced by the provider default
+	assert.Equal(t, 0, getDetectorMinDelay(data(cty.NumberIntVal(0)), 30))
+	assert.Equal(t, 60, getDetectorMinDelay(data(cty.NumberIntVal(60)), 30))
+
+	payload, err := getPayloadDetector(data(cty.NullVal(cty.Number)), 30)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(30000), *payload.MinDelay)
+}
+
+func TestDetectorTagOrderIgnored(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, detectorResource().Schema, map[string]interface{}{})
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Tags: []string{"tag-1", "tag-2"}}, 0))
+	original := d.Get("tags").(*schema.Set)
+
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Tags: []string{"tag-2", "tag-1"}}, 0))
+	assert.True(t, original.Equal(d.Get("tags").(*schema.Set)), "Expected reordered tags to produce the same state")
+
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Tags: []string{"tag-1", "tag-3"}}, 0))
+	assert.False(t, original.Equal(d.Get("tags").(*schema.Set)), "Expected changed tags to change the state")
+}
+
+func TestDetectorRuleOrderIgnored(t *testing.T) {
+	critical := &detector.Rule{DetectLabel: "Latency", Severity: detector.CRITICAL}
+	warning := &detector.Rule{DetectLabel: "Latency", Severity: detector.WARNING, Description: "warn early"}
+	errors := &detector.Rule{DetectLabel: "Errors", Severity: detector.MAJOR}
+
+	// Imported detectors may return their rules in any order
+	d := schema.TestResourceDataRaw(t, detectorResource().Schema, map[string]interface{}{})
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Rules: []*detector.Rule{critical, warning, errors}}, 0))
+	original := d.Get("rule").(*schema.Set)
+
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Rules: []*detector.Rule{errors, warning, critical}}, 0))
+	assert.True(t, original.Equal(d.Get("rule").(*schema.Set)), "Expected reordered rules to produce the same state")
+
+	assert.NoError(t, detectorAPIToTF(d, &detector.Detector{Rules: []*detector.Rule{errors, critical}}, 0))
+	assert.False(t, original.Equal(d.Get("rule").(*schema.Set)), "Expected removed rule to change the state")
+}
+
+func TestDetectorUpdatesInPlace(t *testing.T) {
+	assertNoForceNew(t, "", detectorResource().Schema)
+}
+
+func TestCheckDetectorConflict(t *testing.T) {
+	current := detector.Detector{Id: "abc123", LastUpdated: 1000}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/detector/abc123" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(current)
+	}))
+	defer server.Close()
+
+	client, err := sfx.NewClient("token", sfx.APIUrl(server.URL))
+	assert.NoError(t, err)
+	config := &signalfxConfig{Client: client}
+
+	// Terraform last read the detector at 1000
+	state := &terraform.InstanceState{ID: "abc123", Attributes: map[string]string{"last_updated": "1000"}}
+	d, err := schema.InternalMap(detectorResource().Schema).Data(state, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, checkDetectorUpdateConflict(d, config))
+
+	// Someone edits the detector in the UI before the apply
+	current.LastUpdated = 2000
+	current.LastUpdatedBy = "ABCDEFG"
+	err = checkDetectorUpdateConflict(d, config)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Detector abc123 was modified by ABCDEFG")
+
+	config.IgnoreUpdateConflicts = true
+	assert.NoError(t, checkDetectorUpdateConflict(d, config))
+	config.IgnoreUpdateConflicts = false
+
+	// State written before last_updated was tracked never conflicts
+	d, err = schema.InternalMap(detectorResource().Schema).Data(&terraform.InstanceState{ID: "abc123"}, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, checkDetectorUpdateConflict(d, config))
+}
+
 func TestAccCreateUpdateDetector(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -396,3 +545,32 @@ func TestTimeRangeStateUpgradeV0(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
 	}
 }
+
+func TestValidateSignalflowProgram(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req detector.ValidateDetectorRequestModel
+		if r.URL.Path != "/v2/detector/validate" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if req.ProgramText != "detect(when(data('cpu.utilization') > 50)).publish('CPU')" || len(req.Rules) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"message":"Unknown function 'whne'"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := sfx.NewClient("token", sfx.APIUrl(server.URL))
+	assert.NoError(t, err)
+	config := &signalfxConfig{Client: client}
+	rules := []*detector.Rule{{DetectLabel: "CPU", Severity: detector.CRITICAL}}
+
+	err = validateSignalflowProgram(context.Background(), config, "cpu", "detect(when(data('cpu.utilization') > 50)).publish('CPU')", rules)
+	assert.NoError(t, err)
+
+	err = validateSignalflowProgram(context.Background(), config, "cpu", "detect(whne(data('cpu.utilization') > 50)).publish('CPU')", rules)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Unknown function 'whne'")
+}
@@ -52,8 +52,15 @@ func eventFeedChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: eventFeedChartCreate,
 		Read:   eventFeedChartRead,
 		Update: eventFeedChartUpdate,
@@ -127,6 +134,10 @@ func eventfeedchartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Event Feed Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -187,6 +198,10 @@ func eventFeedChartUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Event Feed Chart Payload: %s", string(debugOutput))
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
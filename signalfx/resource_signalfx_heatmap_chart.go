@@ -166,8 +166,15 @@ func heatmapChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: heatmapchartCreate,
 		Read:   heatmapchartRead,
 		Update: heatmapchartUpdate,
@@ -334,6 +341,10 @@ func heatmapchartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Heatmap Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -436,6 +447,10 @@ func heatmapchartUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -87,6 +87,8 @@ func integrationJiraResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateJiraCredentials,
+
 		Create: integrationJiraCreate,
 		Read:   integrationJiraRead,
 		Update: integrationJiraUpdate,
@@ -98,6 +100,36 @@ func integrationJiraResource() *schema.Resource {
 	}
 }
 
+// Credentials of the other auth method would be silently dropped from the payload
+func validateJiraCredentials(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("auth_method") {
+		return nil
+	}
+	set := map[string]bool{}
+	for _, k := range []string{"username", "password", "user_email", "api_token"} {
+		set[k] = !d.NewValueKnown(k) || d.Get(k).(string) != ""
+	}
+	return jiraCredentialsError(d.Get("auth_method").(string), set)
+}
+
+func jiraCredentialsError(authMethod string, set map[string]bool) error {
+	required, unused := []string{"user_email", "api_token"}, []string{"username", "password"}
+	if authMethod == "UsernameAndPassword" {
+		required, unused = unused, required
+	}
+	for _, k := range unused {
+		if set[k] {
+			return fmt.Errorf("%s can't be used with auth_method %q", k, authMethod)
+		}
+	}
+	for _, k := range required {
+		if !set[k] {
+			return fmt.Errorf("%s is required with auth_method %q", k, authMethod)
+		}
+	}
+	return nil
+}
+
 func integrationJiraExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	config := meta.(*signalfxConfig)
 	_, err := config.Client.GetJiraIntegration(context.TODO(), d.Id())
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const newIntegrationJiraConfig = `
@@ -110,3 +111,13 @@ func testAccIntegrationJiraDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestJiraCredentialsError(t *testing.T) {
+	assert.NoError(t, jiraCredentialsError("UsernameAndPassword", map[string]bool{"username": true, "password": true}))
+	assert.NoError(t, jiraCredentialsError("EmailAndToken", map[string]bool{"user_email": true, "api_token": true}))
+
+	assert.EqualError(t, jiraCredentialsError("UsernameAndPassword", map[string]bool{"username": true}), "password is required with auth_method \"UsernameAndPassword\"")
+	assert.EqualError(t, jiraCredentialsError("EmailAndToken", map[string]bool{"api_token": true}), "user_email is required with auth_method \"EmailAndToken\"")
+	assert.EqualError(t, jiraCredentialsError("EmailAndToken", map[string]bool{"username": true, "password": true}), "username can't be used with auth_method \"EmailAndToken\"")
+	assert.EqualError(t, jiraCredentialsError("UsernameAndPassword", map[string]bool{"user_email": true, "api_token": true}), "user_email can't be used with auth_method \"UsernameAndPassword\"")
+}
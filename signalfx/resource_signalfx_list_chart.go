@@ -7,6 +7,7 @@ import (
 	"log"
 	"math"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	chart "github.com/signalfx/signalfx-go/chart"
@@ -80,9 +81,10 @@ func listChartResource() *schema.Resource {
 				Description:  "The property to use when sorting the elements. Use 'value' if you want to sort by value. Must be prepended with + for ascending or - for descending (e.g. -foo)",
 			},
 			"refresh_interval": &schema.Schema{
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "How often (in seconds) to refresh the values of the list",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "How often (in seconds) to refresh the values of the list",
 			},
 			"legend_fields_to_hide": &schema.Schema{
 				Type:          schema.TypeSet,
@@ -228,8 +230,18 @@ func listChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateListChartColorScale,
+			lastUpdatedComputed,
+		),
+
 		Create: listchartCreate,
 		Read:   listchartRead,
 		Update: listchartUpdate,
@@ -267,7 +279,7 @@ func getPayloadListChart(d *schema.ResourceData) (*chart.CreateUpdateChartReques
 		viz.LegendOptions = legendOptions
 	}
 
-	if vizOptions := getPerSignalVizOptions(d, true); len(vizOptions) > 0 {
+	if vizOptions := buildVizOptions(d.Get("viz_options").(*schema.Set).List(), true); len(vizOptions) > 0 {
 		viz.PublishLabelOptions = vizOptions
 	}
 	payload.Options = viz
@@ -275,6 +287,17 @@ func getPayloadListChart(d *schema.ResourceData) (*chart.CreateUpdateChartReques
 	return payload, nil
 }
 
+/*
+Check the ranges of color_scale at plan time. The check is skipped while the
+color scale is not yet known, e.g. when its bounds come from other resources.
+*/
+func validateListChartColorScale(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !setValueKnown(d, "color_scale") {
+		return nil
+	}
+	return validateColorScale(d.Get("color_scale").(*schema.Set).List())
+}
+
 func getListChartOptions(d *schema.ResourceData) (*chart.Options, error) {
 	options := &chart.Options{
 		Type: "List",
@@ -392,6 +415,10 @@ func listchartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got List Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -456,13 +483,9 @@ func listchartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	}
 
 	if len(options.PublishLabelOptions) > 0 {
-		plos := make([]map[string]interface{}, len(options.PublishLabelOptions))
-		for i, plo := range options.PublishLabelOptions {
-			no, err := publishNonTimeLabelOptionsToMap(plo)
-			if err != nil {
-				return err
-			}
-			plos[i] = no
+		plos, err := flattenVizOptions(options.PublishLabelOptions, false)
+		if err != nil {
+			return err
 		}
 		if err := d.Set("viz_options", plos); err != nil {
 			return err
@@ -530,6 +553,10 @@ func listchartUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update List Chart Payload: %s", string(debugOutput))
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const newListChartConfig = `
@@ -101,6 +103,45 @@ resource "signalfx_list_chart" "mychartLX" {
 }
 `
 
+func TestValidateListChartColorScaleDiff(t *testing.T) {
+	r := listChartResource()
+	block := r.CoreConfigSchema()
+	scaleType := block.ImpliedType().AttributeType("color_scale")
+	// The raw config comes along with the state, as the gRPC server sets it
+	state := &terraform.InstanceState{}
+	config := func(scale cty.Value) *terraform.ResourceConfig {
+		vals := map[string]cty.Value{}
+		for name, ty := range block.ImpliedType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		vals["name"] = cty.StringVal("list")
+		vals["program_text"] = cty.StringVal("data('cpu.utilization').publish()")
+		vals["color_by"] = cty.StringVal("Scale")
+		vals["color_scale"] = scale
+		state.RawConfig = cty.ObjectVal(vals)
+		return terraform.NewResourceConfigShimmed(state.RawConfig, block)
+	}
+	scale := func(gt, gte cty.Value) cty.Value {
+		vals := map[string]cty.Value{}
+		for name, ty := range scaleType.ElementType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		vals["color"] = cty.StringVal("red")
+		vals["gt"] = gt
+		vals["gte"] = gte
+		return cty.SetVal([]cty.Value{cty.ObjectVal(vals)})
+	}
+
+	_, err := r.Diff(context.Background(), state, config(scale(cty.NumberIntVal(10), cty.NumberIntVal(20))), nil)
+	assert.Error(t, err)
+
+	// Scales that are only known at apply time are not checked
+	_, err = r.Diff(context.Background(), state, config(cty.UnknownVal(scaleType)), nil)
+	assert.NoError(t, err)
+	_, err = r.Diff(context.Background(), state, config(scale(cty.NumberIntVal(10), cty.UnknownVal(cty.Number))), nil)
+	assert.NoError(t, err)
+}
+
 func TestAccCreateUpdateListChart(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -56,8 +56,15 @@ func logTimelineResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: logTimelineCreate,
 		Read:   logTimelineRead,
 		Update: logTimelineUpdate,
@@ -107,6 +114,10 @@ func logTimelineAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Log Timeline to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -200,6 +211,10 @@ func logTimelineUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Log Tiemline Payload: %s", string(debugOutput))
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -70,12 +70,12 @@ func logViewResource() *schema.Resource {
 						"field": &schema.Schema{
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "Name of the column",
+							Description: "Name of the field to sort by",
 						},
 						"descending": &schema.Schema{
 							Type:        schema.TypeBool,
 							Required:    true,
-							Description: "Name of the column",
+							Description: "Whether to sort in descending order",
 						},
 					},
 				},
@@ -90,8 +90,15 @@ func logViewResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: logViewCreate,
 		Read:   logViewRead,
 		Update: logViewUpdate,
@@ -206,6 +213,10 @@ func logViewAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Log View to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -303,6 +314,10 @@ func logViewUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Log ViewPayload: %s", string(debugOutput))
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -7,8 +7,10 @@ import (
 	"log"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/signalfx/signalfx-go/orgtoken"
 )
 
@@ -26,12 +28,26 @@ func orgTokenResource() *schema.Resource {
 				Description: "Description of the token (Optional)",
 			},
 			"auth_scopes": &schema.Schema{
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"API", "INGEST", "RUM"}, false),
+				},
 				Computed:    true,
 				Description: "Authentication scope, ex: INGEST, API, RUM ... (Optional)",
 			},
+			"expiry_timestamp": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the token expires, in milliseconds since epoch. Set by the API",
+			},
+			"expired": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether the token had expired at the last refresh. An expired token is replaced on the next apply",
+			},
 			"disabled": &schema.Schema{
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -133,6 +149,8 @@ func orgTokenResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: replaceExpiredOrgToken,
+
 		Create: orgTokenCreate,
 		Read:   orgTokenRead,
 		Update: orgTokenUpdate,
@@ -249,6 +267,21 @@ func orgTokenCreate(d *schema.ResourceData, meta interface{}) error {
 	return orgTokenAPIToTF(d, t)
 }
 
+/*
+An expired token can't be renewed, so plan a replacement once a refresh has
+seen it expire, instead of leaving a dead token in place.
+*/
+func replaceExpiredOrgToken(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.Get("expired").(bool) {
+		return d.SetNew("expired", false)
+	}
+	return nil
+}
+
+func isOrgTokenExpired(expiry int64, now int64) bool {
+	return expiry != 0 && expiry <= now
+}
+
 func orgTokenAPIToTF(d *schema.ResourceData, t *orgtoken.Token) error {
 	debugOutput, _ := json.Marshal(t)
 	log.Printf("[DEBUG] SignalFx: Got Org Token to enState: %s", string(debugOutput))
@@ -263,6 +296,13 @@ func orgTokenAPIToTF(d *schema.ResourceData, t *orgtoken.Token) error {
 		return err
 	}
 
+	if err := d.Set("expiry_timestamp", t.Expiry); err != nil {
+		return err
+	}
+	if err := d.Set("expired", isOrgTokenExpired(t.Expiry, time.Now().UnixNano()/int64(time.Millisecond))); err != nil {
+		return err
+	}
+
 	sort.Strings(t.AuthScopes)
 	if err := d.Set("auth_scopes", t.AuthScopes); err != nil {
 		return err
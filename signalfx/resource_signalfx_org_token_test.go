@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const newOrgTokenConfig = `
@@ -63,7 +64,7 @@ const updatedOrgTokenLimitConfig = `
 resource "signalfx_org_token" "mylimitorgtokenTOK1" {
   name = "LimitToken"
   description = "Limits NEW"
-  auth_scopes = ["INGEST"]
+  auth_scopes = ["API", "INGEST"]
 
   dpm_limits {
     dpm_limit = 2000
@@ -134,6 +135,10 @@ func TestAccCreateUpdateLimitOrgToken(t *testing.T) {
 					testAccCheckOrgTokenResourceExists,
 					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "name", "LimitToken"),
 					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "description", "Limits NEW"),
+					// Scopes are updated in place
+					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "auth_scopes.#", "2"),
+					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "auth_scopes.0", "API"),
+					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "auth_scopes.1", "INGEST"),
 					resource.TestCheckResourceAttr("signalfx_org_token.mylimitorgtokenTOK1", "dpm_limits.#", "1"),
 				),
 			},
@@ -174,3 +179,11 @@ func testAccOrgTokenDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestIsOrgTokenExpired(t *testing.T) {
+	now := int64(1700000000000)
+	assert.False(t, isOrgTokenExpired(0, now), "tokens without expiry never expire")
+	assert.False(t, isOrgTokenExpired(now+1000, now))
+	assert.True(t, isOrgTokenExpired(now, now))
+	assert.True(t, isOrgTokenExpired(now-1000, now))
+}
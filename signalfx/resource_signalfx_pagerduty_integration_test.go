@@ -25,7 +25,16 @@ resource "signalfx_pagerduty_integration" "pagerduty_myteamXX" {
 }
 `
 
+const rotatedKeyIntegrationPagerDutyConfig = `
+resource "signalfx_pagerduty_integration" "pagerduty_myteamXX" {
+    name = "PD - My Team NEW"
+    enabled = false
+    api_key = "0987654321"
+}
+`
+
 func TestAccCreateUpdateIntegrationPagerDuty(t *testing.T) {
+	var id string
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -49,6 +58,23 @@ func TestAccCreateUpdateIntegrationPagerDuty(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIntegrationPagerDutyResourceExists,
 					resource.TestCheckResourceAttr("signalfx_pagerduty_integration.pagerduty_myteamXX", "name", "PD - My Team NEW"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["signalfx_pagerduty_integration.pagerduty_myteamXX"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Rotating the key must keep the integration, detectors refer to its ID
+			{
+				Config: rotatedKeyIntegrationPagerDutyConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationPagerDutyResourceExists,
+					func(s *terraform.State) error {
+						if newID := s.RootModule().Resources["signalfx_pagerduty_integration.pagerduty_myteamXX"].Primary.ID; newID != id {
+							return fmt.Errorf("Integration was replaced when rotating the key: %s became %s", id, newID)
+						}
+						return nil
+					},
 				),
 			},
 		},
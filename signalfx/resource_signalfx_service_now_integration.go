@@ -9,7 +9,9 @@ package signalfx
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,9 @@ const (
 	serviceNowTypeProblem     = "Problem"
 )
 
+// Mustache tags such as {{{messageTitle}}} that the templates may contain
+var serviceNowTemplateTag = regexp.MustCompile(`\{\{\{?[^{}]*\}?\}\}`)
+
 func integrationServiceNowResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -58,14 +63,16 @@ func integrationServiceNowResource() *schema.Resource {
 				Description:  fmt.Sprintf("The type of issue in standard ITIL terminology. The allowed values are `%s` and `%s`.", serviceNowTypeIncident, serviceNowTypeProblem),
 			},
 			"alert_triggered_payload_template": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "A template that Observability Cloud uses to create the ServiceNow POST JSON payloads when an alert sends a notification to ServiceNow. Use this optional field to send the values of Observability Cloud alert properties to specific fields in ServiceNow. See API reference for details.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateServiceNowPayloadTemplate,
+				Description:  "A template that Observability Cloud uses to create the ServiceNow POST JSON payloads when an alert sends a notification to ServiceNow. Use this optional field to send the values of Observability Cloud alert properties to specific fields in ServiceNow. See API reference for details.",
 			},
 			"alert_resolved_payload_template": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "A template that Observability Cloud uses to create the ServiceNow PUT JSON payloads when an alert is cleared in ServiceNow. Use this optional field to send the values of Observability Cloud alert properties to specific fields in ServiceNow. See API reference for details.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateServiceNowPayloadTemplate,
+				Description:  "A template that Observability Cloud uses to create the ServiceNow PUT JSON payloads when an alert is cleared in ServiceNow. Use this optional field to send the values of Observability Cloud alert properties to specific fields in ServiceNow. See API reference for details.",
 			},
 		},
 
@@ -80,6 +87,18 @@ func integrationServiceNowResource() *schema.Resource {
 	}
 }
 
+/*
+Payload templates must be JSON once their tags are filled in. Tags are replaced
+by a number so that they can be used both inside strings and as values.
+*/
+func validateServiceNowPayloadTemplate(v interface{}, k string) (we []string, errors []error) {
+	filled := serviceNowTemplateTag.ReplaceAllString(v.(string), "0")
+	if err := json.Unmarshal([]byte(filled), &map[string]interface{}{}); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be a JSON object, optionally with {{{property}}} tags, got: %s", k, v.(string)))
+	}
+	return we, errors
+}
+
 func getServiceNowIntegration(d *schema.ResourceData) *integration.ServiceNowIntegration {
 	snow := &integration.ServiceNowIntegration{
 		Type:         integration.SERVICE_NOW,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 const newIntegrationServiceNowConfig = `
@@ -74,3 +75,22 @@ func TestAccCreateUpdateIntegrationServiceNow(t *testing.T) {
 		},
 	})
 }
+
+func TestValidateServiceNowPayloadTemplate(t *testing.T) {
+	for _, tmpl := range []string{
+		`{"short_description": "{{{messageTitle}}} (customized)"}`,
+		`{"close_notes": "{{messageBody}}", "impact": {{{impact}}}}`,
+		`{}`,
+	} {
+		_, errs := validateServiceNowPayloadTemplate(tmpl, "alert_triggered_payload_template")
+		assert.Empty(t, errs, tmpl)
+	}
+	for _, tmpl := range []string{
+		`{"short_description": "{{{messageTitle}}}"`,
+		`"{{{messageTitle}}}"`,
+		`short_description={{{messageTitle}}}`,
+	} {
+		_, errs := validateServiceNowPayloadTemplate(tmpl, "alert_triggered_payload_template")
+		assert.Len(t, errs, 1, tmpl)
+	}
+}
@@ -61,14 +61,16 @@ func singleValueChartResource() *schema.Resource {
 				Description: "The property value is a string that denotes the geographic region associated with the time zone, (e.g. Australia/Sydney)",
 			},
 			"refresh_interval": &schema.Schema{
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "How often (in seconds) to refresh the values of the list",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "How often (in seconds) to refresh the value of the chart",
 			},
 			"max_precision": &schema.Schema{
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The maximum precision to for values displayed in the list",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 6),
+				Description:  "The maximum precision to for values displayed in the chart, between 0 and 6",
 			},
 			"is_timestamp_hidden": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -86,7 +88,7 @@ func singleValueChartResource() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "None",
-				Description:  "(false by default) What kind of secondary visualization to show (None, Radial, Linear, Sparkline)",
+				Description:  "(None by default) What kind of secondary visualization to show (None, Radial, Linear, Sparkline)",
 				ValidateFunc: validateSecondaryVisualization,
 			},
 			"color_scale": &schema.Schema{
@@ -174,8 +176,15 @@ func singleValueChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: singlevaluechartCreate,
 		Read:   singlevaluechartRead,
 		Update: singlevaluechartUpdate,
@@ -198,7 +207,7 @@ func getPayloadSingleValueChart(d *schema.ResourceData) *chart.CreateUpdateChart
 	}
 
 	viz := getSingleValueChartOptions(d)
-	if vizOptions := getPerSignalVizOptions(d, true); len(vizOptions) > 0 {
+	if vizOptions := buildVizOptions(d.Get("viz_options").(*schema.Set).List(), true); len(vizOptions) > 0 {
 		viz.PublishLabelOptions = vizOptions
 	}
 	payload.Options = viz
@@ -292,6 +301,10 @@ func singlevaluechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Single Value Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -338,13 +351,9 @@ func singlevaluechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	}
 
 	if len(options.PublishLabelOptions) > 0 {
-		plos := make([]map[string]interface{}, len(options.PublishLabelOptions))
-		for i, plo := range options.PublishLabelOptions {
-			no, err := publishNonTimeLabelOptionsToMap(plo)
-			if err != nil {
-				return err
-			}
-			plos[i] = no
+		plos, err := flattenVizOptions(options.PublishLabelOptions, false)
+		if err != nil {
+			return err
 		}
 		if err := d.Set("viz_options", plos); err != nil {
 			return err
@@ -424,6 +433,10 @@ func singlevaluechartUpdate(d *schema.ResourceData, meta interface{}) error {
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Single Value Chart Payload: %s", string(debugOutput))
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -80,8 +80,8 @@ resource "signalfx_single_value_chart" "mychartSVX" {
 
   max_delay = 15
   timezone = "Europe/Paris"
-  refresh_interval = 1
-  max_precision = 2
+  refresh_interval = 5
+  max_precision = 3
   unit_prefix = "Binary"
   secondary_visualization = "Sparkline"
 	is_timestamp_hidden = true
@@ -133,6 +133,8 @@ func TestAccCreateUpdateSingleValueChart(t *testing.T) {
 					testAccCheckSingleValueChartResourceExists,
 					resource.TestCheckResourceAttr("signalfx_single_value_chart.mychartSVX", "name", "CPU Total Idle - Single Value NEW"),
 					resource.TestCheckResourceAttr("signalfx_single_value_chart.mychartSVX", "description", "Farts NEW"),
+					resource.TestCheckResourceAttr("signalfx_single_value_chart.mychartSVX", "refresh_interval", "5"),
+					resource.TestCheckResourceAttr("signalfx_single_value_chart.mychartSVX", "max_precision", "3"),
 				),
 			},
 		},
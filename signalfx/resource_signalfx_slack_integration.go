@@ -30,6 +30,11 @@ func integrationSlackResource() *schema.Resource {
 				Description: "Slack Webhook URL for integration",
 				Sensitive:   true,
 			},
+			"workspace_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Slack workspace the integration posts to, if known",
+			},
 		},
 
 		Create: integrationSlackCreate,
@@ -87,6 +92,9 @@ func slackIntegrationAPIToTF(d *schema.ResourceData, slack *integration.SlackInt
 	if err := d.Set("enabled", slack.Enabled); err != nil {
 		return err
 	}
+	if err := d.Set("workspace_name", slack.SlackTeamName); err != nil {
+		return err
+	}
 	// Note, the API doesn't return a Webhook URL so we ignore it
 	return nil
 }
@@ -87,6 +87,11 @@ func tableChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 			"viz_options": &schema.Schema{
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -130,6 +135,8 @@ func tableChartResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: lastUpdatedComputed,
+
 		Create: tablechartCreate,
 		Read:   tablechartRead,
 		Update: tablechartUpdate,
@@ -156,7 +163,7 @@ func getPayloadTableChart(d *schema.ResourceData) (*chart.CreateUpdateChartReque
 		return nil, err
 	}
 
-	if vizOptions := getPerSignalVizOptions(d, false); len(vizOptions) > 0 {
+	if vizOptions := buildVizOptions(d.Get("viz_options").(*schema.Set).List(), false); len(vizOptions) > 0 {
 		options.PublishLabelOptions = vizOptions
 	}
 
@@ -252,6 +259,10 @@ func tablechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Table Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -317,13 +328,9 @@ func tablechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	}
 
 	if len(options.PublishLabelOptions) > 0 {
-		plos := make([]map[string]interface{}, len(options.PublishLabelOptions))
-		for i, plo := range options.PublishLabelOptions {
-			no, err := publishNonTimeLabelOptionsToMap(plo)
-			if err != nil {
-				return err
-			}
-			plos[i] = no
+		plos, err := flattenVizOptions(options.PublishLabelOptions, false)
+		if err != nil {
+			return err
 		}
 		if err := d.Set("viz_options", plos); err != nil {
 			return err
@@ -358,6 +365,10 @@ func tablechartUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
@@ -8,6 +8,7 @@ import (
 	"math"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -132,9 +133,10 @@ func timeChartResource() *schema.Resource {
 				}, false),
 			},
 			"minimum_resolution": &schema.Schema{
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The minimum resolution (in seconds) to use for computing the underlying program",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The minimum resolution (in seconds) to use for computing the underlying program",
 			},
 			"max_delay": &schema.Schema{
 				Type:         schema.TypeInt,
@@ -488,6 +490,11 @@ func timeChartResource() *schema.Resource {
 				Computed:    true,
 				Description: "URL of the chart",
 			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Time the chart was last updated, in milliseconds since epoch",
+			},
 		},
 
 		SchemaVersion: 1,
@@ -499,6 +506,12 @@ func timeChartResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateTimeChartRightAxis,
+			validateTimeChartAxesRange,
+			lastUpdatedComputed,
+		),
+
 		Create: timechartCreate,
 		Read:   timechartRead,
 		Update: timechartUpdate,
@@ -510,6 +523,55 @@ func timeChartResource() *schema.Resource {
 	}
 }
 
+/*
+Plots moved to the right axis are drawn against axis_right, so require it to
+be defined rather than letting the UI pick its bounds and labels.
+*/
+func validateTimeChartRightAxis(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	_, hasRightAxis := d.GetOk("axis_right")
+	return validateRightAxisDefined(d.Get("viz_options").(*schema.Set).List(), hasRightAxis)
+}
+
+func validateRightAxisDefined(tfVizOptions []interface{}, hasRightAxis bool) error {
+	if hasRightAxis {
+		return nil
+	}
+	for _, v := range tfVizOptions {
+		v := v.(map[string]interface{})
+		if v["axis"] == "right" {
+			return fmt.Errorf("viz_options %q uses the right axis, but no axis_right is defined", v["label"])
+		}
+	}
+	return nil
+}
+
+/*
+Reject empty or inverted axis ranges at plan time. Unset bounds default to
+-MaxFloat64 and MaxFloat64 so they never trip the check. Axes that are not yet
+known, e.g. with bounds from other resources, are skipped.
+*/
+func validateTimeChartAxesRange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"axis_left", "axis_right"} {
+		if !setValueKnown(d, key) {
+			continue
+		}
+		for _, axis := range d.Get(key).(*schema.Set).List() {
+			axis := axis.(map[string]interface{})
+			if err := validateAxisRange(key, axis["min_value"].(float64), axis["max_value"].(float64)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateAxisRange(key string, minValue float64, maxValue float64) error {
+	if minValue >= maxValue {
+		return fmt.Errorf("%s min_value (%v) must be less than max_value (%v)", key, minValue, maxValue)
+	}
+	return nil
+}
+
 /*
 Use Resource object to construct json payload in order to create a time chart
 */
@@ -545,7 +607,7 @@ func getPayloadTimeChart(d *schema.ResourceData) *chart.CreateUpdateChartRequest
 		viz.LegendOptions = legendOptions
 	}
 
-	if vizOptions := getPerSignalVizOptions(d, true); len(vizOptions) > 0 {
+	if vizOptions := buildVizOptions(d.Get("viz_options").(*schema.Set).List(), true); len(vizOptions) > 0 {
 		viz.PublishLabelOptions = vizOptions
 	}
 	if eventOptions := getPerEventOptions(d); len(eventOptions) > 0 {
@@ -567,48 +629,6 @@ func getPayloadTimeChart(d *schema.ResourceData) *chart.CreateUpdateChartRequest
 	return payload
 }
 
-func getPerSignalVizOptions(d *schema.ResourceData, includePaletteIndex bool) []*chart.PublishLabelOptions {
-	viz := d.Get("viz_options").(*schema.Set).List()
-	vizList := make([]*chart.PublishLabelOptions, len(viz))
-	for i, v := range viz {
-		v := v.(map[string]interface{})
-		item := &chart.PublishLabelOptions{
-			Label: v["label"].(string),
-		}
-		if val, ok := v["display_name"].(string); ok && val != "" {
-			item.DisplayName = val
-		}
-		if val, ok := v["color"].(string); ok {
-			if elem, ok := PaletteColors[val]; includePaletteIndex && ok {
-				i := int32(elem)
-				item.PaletteIndex = &i
-			}
-		}
-		if val, ok := v["plot_type"].(string); ok && val != "" {
-			item.PlotType = val
-		}
-		if val, ok := v["axis"].(string); ok && val != "" {
-			if val == "right" {
-				item.YAxis = int32(1)
-			} else {
-				item.YAxis = int32(0)
-			}
-		}
-		if val, ok := v["value_unit"].(string); ok && val != "" {
-			item.ValueUnit = val
-		}
-		if val, ok := v["value_suffix"].(string); ok && val != "" {
-			item.ValueSuffix = val
-		}
-		if val, ok := v["value_prefix"].(string); ok && val != "" {
-			item.ValuePrefix = val
-		}
-
-		vizList[i] = item
-	}
-	return vizList
-}
-
 func getPerEventOptions(d *schema.ResourceData) []*chart.EventPublishLabelOptions {
 	eos := d.Get("event_options").(*schema.Set).List()
 	eventList := make([]*chart.EventPublishLabelOptions, len(eos))
@@ -826,6 +846,10 @@ func timechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	debugOutput, _ := json.Marshal(c)
 	log.Printf("[DEBUG] SignalFx: Got Time Chart to enState: %s", string(debugOutput))
 
+	if err := d.Set("last_updated", c.LastUpdated); err != nil {
+		return err
+	}
+
 	if err := d.Set("name", c.Name); err != nil {
 		return err
 	}
@@ -952,13 +976,9 @@ func timechartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
 	}
 
 	if len(options.PublishLabelOptions) > 0 {
-		plos := make([]map[string]interface{}, len(options.PublishLabelOptions))
-		for i, plo := range options.PublishLabelOptions {
-			no, err := publishLabelOptionsToMap(plo)
-			if err != nil {
-				return err
-			}
-			plos[i] = no
+		plos, err := flattenVizOptions(options.PublishLabelOptions, true)
+		if err != nil {
+			return err
 		}
 		if err := d.Set("viz_options", plos); err != nil {
 			return err
@@ -1055,61 +1075,14 @@ func axisToMap(axis *chart.Axes) []*map[string]interface{} {
 	return nil
 }
 
-// This function handles a LabelOptions for non-time charts.
-func publishNonTimeLabelOptionsToMap(options *chart.PublishLabelOptions) (map[string]interface{}, error) {
-	color := ""
-	if options.PaletteIndex != nil {
-		// We might not have a color, so tread lightly
-		c, err := getNameFromPaletteColorsByIndex(int(*options.PaletteIndex))
-		if err != nil {
-			return map[string]interface{}{}, err
-		}
-		// Ok, we can set the color now
-		color = c
-	}
-
-	return map[string]interface{}{
-		"label":        options.Label,
-		"display_name": options.DisplayName,
-		"color":        color,
-		"value_unit":   options.ValueUnit,
-		"value_suffix": options.ValueSuffix,
-		"value_prefix": options.ValuePrefix,
-	}, nil
-}
-
-func publishLabelOptionsToMap(options *chart.PublishLabelOptions) (map[string]interface{}, error) {
-	color := ""
-	if options.PaletteIndex != nil {
-		// We might not have a color, so tread lightly
-		c, err := getNameFromPaletteColorsByIndex(int(*options.PaletteIndex))
-		if err != nil {
-			return map[string]interface{}{}, err
-		}
-		// Ok, we can set the color now
-		color = c
-	}
-	axis := "left"
-	if options.YAxis == 1 {
-		axis = "right"
-	}
-
-	return map[string]interface{}{
-		"label":        options.Label,
-		"display_name": options.DisplayName,
-		"color":        color,
-		"axis":         axis,
-		"plot_type":    options.PlotType,
-		"value_unit":   options.ValueUnit,
-		"value_suffix": options.ValueSuffix,
-		"value_prefix": options.ValuePrefix,
-	}, nil
-}
-
 func timechartUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
 	payload := getPayloadTimeChart(d)
 
+	if err := checkChartUpdateConflict(d, config); err != nil {
+		return err
+	}
+
 	c, err := config.Client.UpdateChart(context.TODO(), d.Id(), payload)
 	if err != nil {
 		return err
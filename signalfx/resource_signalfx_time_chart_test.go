@@ -3,10 +3,15 @@ package signalfx
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	chart "github.com/signalfx/signalfx-go/chart"
+	"github.com/stretchr/testify/assert"
 )
 
 const newTimeChartConfig = `
@@ -284,3 +289,101 @@ func testAccTimeChartDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestTimeChartVizOptionsSplitAcrossAxes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, timeChartResource().Schema, map[string]interface{}{
+		"name":         "Requests and errors",
+		"program_text": "data('requests').publish('A')\ndata('errors').publish('B')",
+		"viz_options": []interface{}{
+			map[string]interface{}{"label": "A"},
+			map[string]interface{}{"label": "B", "axis": "right"},
+		},
+		"axis_right": []interface{}{map[string]interface{}{"label": "Errors"}},
+	})
+
+	axes := map[string]int32{}
+	for _, plo := range getPayloadTimeChart(d).Options.PublishLabelOptions {
+		axes[plo.Label] = plo.YAxis
+	}
+	assert.Equal(t, map[string]int32{"A": 0, "B": 1}, axes)
+
+	vizOptions := d.Get("viz_options").(*schema.Set).List()
+	assert.NoError(t, validateRightAxisDefined(vizOptions, true))
+	assert.Error(t, validateRightAxisDefined(vizOptions, false))
+	assert.NoError(t, validateRightAxisDefined(vizOptions[:0], false))
+}
+
+func TestTimeChartEventOptionsKeptApartFromVizOptions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, timeChartResource().Schema, map[string]interface{}{
+		"name":         "Requests and deploys",
+		"program_text": "data('requests').publish('A')\nevents(eventType='deploy').publish('E')",
+		"viz_options": []interface{}{
+			map[string]interface{}{"label": "A", "color": "orange"},
+		},
+		"event_options": []interface{}{
+			map[string]interface{}{"label": "E", "color": "azure", "display_name": "Deploys"},
+		},
+	})
+
+	options := getPayloadTimeChart(d).Options
+	assert.Len(t, options.PublishLabelOptions, 1)
+	assert.Equal(t, "A", options.PublishLabelOptions[0].Label)
+	assert.Len(t, options.EventPublishLabelOptions, 1)
+	assert.Equal(t, "Deploys", options.EventPublishLabelOptions[0].DisplayName)
+
+	read := schema.TestResourceDataRaw(t, timeChartResource().Schema, map[string]interface{}{})
+	assert.NoError(t, timechartAPIToTF(read, &chart.Chart{Options: options}))
+	viz := read.Get("viz_options").(*schema.Set).List()
+	assert.Len(t, viz, 1)
+	assert.Equal(t, "orange", viz[0].(map[string]interface{})["color"])
+	events := read.Get("event_options").(*schema.Set).List()
+	assert.Len(t, events, 1)
+	assert.Equal(t, "azure", events[0].(map[string]interface{})["color"])
+	assert.Equal(t, "Deploys", events[0].(map[string]interface{})["display_name"])
+}
+
+func TestValidateAxisRange(t *testing.T) {
+	assert.NoError(t, validateAxisRange("axis_left", 0.001, 100))
+	assert.NoError(t, validateAxisRange("axis_left", -math.MaxFloat64, math.MaxFloat64))
+	assert.Error(t, validateAxisRange("axis_left", 100, 100))
+	assert.Error(t, validateAxisRange("axis_right", 100, 0.001))
+}
+
+func TestValidateTimeChartAxesRangeDiff(t *testing.T) {
+	r := timeChartResource()
+	block := r.CoreConfigSchema()
+	axisType := block.ImpliedType().AttributeType("axis_left")
+	// The raw config comes along with the state, as the gRPC server sets it
+	state := &terraform.InstanceState{}
+	config := func(axis cty.Value) *terraform.ResourceConfig {
+		vals := map[string]cty.Value{}
+		for name, ty := range block.ImpliedType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		vals["name"] = cty.StringVal("time")
+		vals["program_text"] = cty.StringVal("data('cpu.utilization').publish()")
+		vals["axis_left"] = axis
+		state.RawConfig = cty.ObjectVal(vals)
+		return terraform.NewResourceConfigShimmed(state.RawConfig, block)
+	}
+	axis := func(minValue, maxValue cty.Value) cty.Value {
+		vals := map[string]cty.Value{}
+		for name, ty := range axisType.ElementType().AttributeTypes() {
+			vals[name] = cty.NullVal(ty)
+		}
+		vals["min_value"] = minValue
+		vals["max_value"] = maxValue
+		return cty.SetVal([]cty.Value{cty.ObjectVal(vals)})
+	}
+
+	_, err := r.Diff(context.Background(), state, config(axis(cty.NumberFloatVal(100), cty.NumberFloatVal(0.001))), nil)
+	assert.Error(t, err)
+	_, err = r.Diff(context.Background(), state, config(axis(cty.NumberFloatVal(0.001), cty.NumberFloatVal(100))), nil)
+	assert.NoError(t, err)
+
+	// Axes that are only known at apply time are not checked
+	_, err = r.Diff(context.Background(), state, config(axis(cty.NumberFloatVal(0.001), cty.UnknownVal(cty.Number))), nil)
+	assert.NoError(t, err)
+	_, err = r.Diff(context.Background(), state, config(cty.UnknownVal(axisType)), nil)
+	assert.NoError(t, err)
+}
@@ -27,7 +27,8 @@ func integrationVictorOpsResource() *schema.Resource {
 			"post_url": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Opsgenie API URL for integration",
+				Description: "Splunk On-Call REST API URL for integration, including the API key",
+				Sensitive:   true,
 			},
 		},
 
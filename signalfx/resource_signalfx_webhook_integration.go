@@ -32,7 +32,7 @@ func integrationWebhookResource() *schema.Resource {
 			"shared_secret": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "",
+				Description: "Shared secret used to sign the webhook requests",
 				Sensitive:   true,
 			},
 			"headers": &schema.Schema{
@@ -130,8 +130,12 @@ func webhookIntegrationAPIToTF(d *schema.ResourceData, og *integration.WebhookIn
 	if err := d.Set("url", og.Url); err != nil {
 		return err
 	}
-	if err := d.Set("shared_secret", og.SharedSecret); err != nil {
-		return err
+	// The API doesn't always return the shared secret, so only update it when
+	// it does to avoid a perpetual diff against the configured value
+	if og.SharedSecret != "" {
+		if err := d.Set("shared_secret", og.SharedSecret); err != nil {
+			return err
+		}
 	}
 	if len(og.Headers) > 0 {
 		headers := make([]map[string]interface{}, len(og.Headers))
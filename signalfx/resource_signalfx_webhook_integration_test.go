@@ -6,7 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/integration"
+	"github.com/stretchr/testify/assert"
 )
 
 const newIntegrationWebhookConfig = `
@@ -103,3 +106,21 @@ func testAccIntegrationWebhookDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestWebhookIntegrationSharedSecretKept(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, integrationWebhookResource().Schema, map[string]interface{}{
+		"name":          "Webhook - My Team",
+		"enabled":       true,
+		"url":           "https://www.example.com",
+		"shared_secret": "abc1234",
+	})
+
+	// The API omits the secret, so the configured value must be kept
+	err := webhookIntegrationAPIToTF(d, &integration.WebhookIntegration{
+		Name:    "Webhook - My Team",
+		Enabled: true,
+		Url:     "https://www.example.com",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc1234", d.Get("shared_secret"))
+}
@@ -12,6 +12,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	chart "github.com/signalfx/signalfx-go/chart"
@@ -224,6 +226,66 @@ func getColorScaleOptionsFromSlice(colorScale []interface{}) []*chart.SecondaryV
 	return item
 }
 
+type colorScaleRange struct {
+	lo, hi                 float64
+	loIncluded, hiIncluded bool
+}
+
+func colorScaleRangeNotEmpty(lo float64, loIncluded bool, hi float64, hiIncluded bool) bool {
+	return lo < hi || (lo == hi && loIncluded && hiIncluded)
+}
+
+/*
+Checks that every color_scale range sets a threshold, uses at most one of
+gt/gte and of lt/lte, and that no value falls into two ranges, as the color
+shown would then be arbitrary.
+*/
+func validateColorScale(colorScale []interface{}) error {
+	ranges := make([]colorScaleRange, len(colorScale))
+	for i := range colorScale {
+		scale := colorScale[i].(map[string]interface{})
+		color := scale["color"]
+		gt := getValueUsingMaxFloatAsDefault(scale["gt"].(float64))
+		gte := getValueUsingMaxFloatAsDefault(scale["gte"].(float64))
+		lt := getValueUsingMaxFloatAsDefault(scale["lt"].(float64))
+		lte := getValueUsingMaxFloatAsDefault(scale["lte"].(float64))
+
+		if gt == nil && gte == nil && lt == nil && lte == nil {
+			return fmt.Errorf("color_scale %q must set at least one of gt, gte, lt or lte", color)
+		}
+		if gt != nil && gte != nil {
+			return fmt.Errorf("color_scale %q can only set one of gt and gte", color)
+		}
+		if lt != nil && lte != nil {
+			return fmt.Errorf("color_scale %q can only set one of lt and lte", color)
+		}
+
+		r := colorScaleRange{lo: math.Inf(-1), hi: math.Inf(1)}
+		if gt != nil {
+			r.lo = *gt
+		} else if gte != nil {
+			r.lo, r.loIncluded = *gte, true
+		}
+		if lt != nil {
+			r.hi = *lt
+		} else if lte != nil {
+			r.hi, r.hiIncluded = *lte, true
+		}
+		if !colorScaleRangeNotEmpty(r.lo, r.loIncluded, r.hi, r.hiIncluded) {
+			return fmt.Errorf("color_scale %q matches no value, its lower threshold is above its upper threshold", color)
+		}
+
+		for j, other := range ranges[:i] {
+			if colorScaleRangeNotEmpty(r.lo, r.loIncluded, other.hi, other.hiIncluded) &&
+				colorScaleRangeNotEmpty(other.lo, other.loIncluded, r.hi, r.hiIncluded) {
+				return fmt.Errorf("color_scale %q and %q overlap", colorScale[j].(map[string]interface{})["color"], color)
+			}
+		}
+		ranges[i] = r
+	}
+	return nil
+}
+
 /*
 Send a GET to get the current state of the resource. It just checks if the lastUpdated timestamp is
 later than the timestamp saved in the resource. If so, the resource has been modified in some way
@@ -379,6 +441,19 @@ func validateSignalfxRelativeTime(v interface{}, k string) (we []string, errors
 	return
 }
 
+/*
+Util method to validate IANA time zone names, e.g. America/New_York. The time
+zone database is embedded so validation doesn't depend on the host.
+*/
+func validateTimezone(v interface{}, k string) (we []string, errors []error) {
+	tz := v.(string)
+
+	if _, err := time.LoadLocation(tz); err != nil || tz == "" || tz == "Local" {
+		errors = append(errors, fmt.Errorf("%s is not a valid time zone for %s, please use an IANA time zone name (e.g. America/New_York)", tz, k))
+	}
+	return
+}
+
 /*
 *  Util method to convert from Splunk Observability Cloud string format to milliseconds
  */
@@ -445,3 +520,93 @@ func validateSecondaryVisualization(v interface{}, k string) (we []string, error
 	errors = append(errors, fmt.Errorf("%s not allowed; must be one of: %s", value, strings.Join(allowedWords, ", ")))
 	return
 }
+
+/*
+The API has no conditional update, so compare the last update time Terraform
+saw on read with the server's to avoid overwriting changes made in the UI
+between the plan and the apply. State written before last_updated was tracked
+is never considered a conflict.
+*/
+func checkUpdateConflict(kind string, id string, lastUpdated int64, currentLastUpdated int64, currentLastUpdatedBy string) error {
+	if lastUpdated == 0 || currentLastUpdated == lastUpdated {
+		return nil
+	}
+	by := currentLastUpdatedBy
+	if by == "" {
+		by = "another user"
+	}
+	return fmt.Errorf("%s %s was modified by %s since Terraform last read it, refresh the state and apply again or set ignore_update_conflicts in the provider to overwrite those changes", kind, id, by)
+}
+
+/*
+The last update time Terraform saw on read. last_updated is unknown in the
+plan of any update, so take it from the prior state.
+*/
+func readLastUpdated(d *schema.ResourceData) int64 {
+	lastUpdated, _ := d.GetChange("last_updated")
+	return int64(lastUpdated.(int))
+}
+
+func checkChartUpdateConflict(d *schema.ResourceData, config *signalfxConfig) error {
+	if config.IgnoreUpdateConflicts {
+		return nil
+	}
+	c, err := config.Client.GetChart(context.TODO(), d.Id())
+	if err != nil {
+		return err
+	}
+	return checkUpdateConflict("Chart", d.Id(), readLastUpdated(d), c.LastUpdated, c.LastUpdatedBy)
+}
+
+func checkDashboardUpdateConflict(d *schema.ResourceData, config *signalfxConfig) error {
+	if config.IgnoreUpdateConflicts {
+		return nil
+	}
+	dash, err := config.Client.GetDashboard(context.TODO(), d.Id())
+	if err != nil {
+		return err
+	}
+	return checkUpdateConflict("Dashboard", d.Id(), readLastUpdated(d), dash.LastUpdated, dash.LastUpdatedBy)
+}
+
+func checkDetectorUpdateConflict(d *schema.ResourceData, config *signalfxConfig) error {
+	if config.IgnoreUpdateConflicts {
+		return nil
+	}
+	det, err := config.Client.GetDetector(context.TODO(), d.Id())
+	if err != nil {
+		return err
+	}
+	return checkUpdateConflict("Detector", d.Id(), readLastUpdated(d), det.LastUpdated, det.LastUpdatedBy)
+}
+
+/*
+Whether a set of blocks is known at plan time. NewValueKnown stays true for a
+set whose elements have unknown fields, which read as zero values, so the raw
+config is checked too.
+*/
+func setValueKnown(d *schema.ResourceDiff, key string) bool {
+	if !d.NewValueKnown(key) || !d.NewValueKnown(key+".#") {
+		return false
+	}
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr(key).IsWhollyKnown() {
+		return false
+	}
+	return true
+}
+
+/*
+Every update bumps last_updated on the server, so plan it as unknown whenever
+anything else changes rather than leaving a stale value in the plan.
+*/
+func lastUpdatedComputed(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, k := range d.GetChangedKeysPrefix("") {
+		if k != "last_updated" {
+			return d.SetNewComputed("last_updated")
+		}
+	}
+	return nil
+}
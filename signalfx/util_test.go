@@ -1,11 +1,16 @@
 package signalfx
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sfx "github.com/signalfx/signalfx-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -153,3 +158,92 @@ func TestFlattenStringSliceToSet(t *testing.T) {
 	setWithEmptyStrings := flattenStringSliceToSet([]string{"a", "", "b"})
 	assert.Equal(t, 2, setWithEmptyStrings.Len(), "Set missing arguments")
 }
+
+func TestValidateTimezone(t *testing.T) {
+	for _, tz := range []string{"UTC", "America/New_York", "Australia/Sydney"} {
+		_, errors := validateTimezone(tz, "timezone")
+		assert.Equal(t, 0, len(errors), tz)
+	}
+}
+
+func TestValidateTimezoneNotAllowed(t *testing.T) {
+	for _, tz := range []string{"", "Local", "Mars/Olympus_Mons"} {
+		_, errors := validateTimezone(tz, "timezone")
+		assert.Equal(t, 1, len(errors), tz)
+	}
+}
+
+func TestCheckUpdateConflict(t *testing.T) {
+	assert.NoError(t, checkUpdateConflict("Chart", "abc123", 1000, 1000, "ABCDEFG"))
+
+	err := checkUpdateConflict("Chart", "abc123", 1000, 2000, "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Chart abc123 was modified by another user")
+	assert.Contains(t, err.Error(), "ignore_update_conflicts")
+}
+
+func TestLastUpdatedComputed(t *testing.T) {
+	r := textChartResource()
+	state := &terraform.InstanceState{
+		ID: "abc123",
+		Attributes: map[string]string{
+			"name":         "notes",
+			"markdown":     "# Notes",
+			"url":          "https://app.signalfx.com/#/chart/abc123",
+			"last_updated": "1000",
+		},
+	}
+	config := func(markdown string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{"name": "notes", "markdown": markdown})
+	}
+
+	diff, err := r.Diff(context.Background(), state, config("# Notes"), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty())
+
+	diff, err = r.Diff(context.Background(), state, config("# More notes"), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["last_updated"].NewComputed)
+
+	// The conflict check compares against the last_updated from the state
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"id": "abc123", "lastUpdated": 2000, "lastUpdatedBy": "ABCDEFG"}`)
+	}))
+	defer server.Close()
+	client, err := sfx.NewClient("token", sfx.APIUrl(server.URL))
+	assert.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	assert.NoError(t, err)
+	err = checkChartUpdateConflict(d, &signalfxConfig{Client: client})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Chart abc123 was modified by ABCDEFG")
+	assert.NoError(t, checkChartUpdateConflict(d, &signalfxConfig{Client: client, IgnoreUpdateConflicts: true}))
+}
+
+func TestValidateColorScale(t *testing.T) {
+	unset := float64(math.MaxFloat32)
+	scale := func(color string, gt, gte, lt, lte float64) interface{} {
+		return map[string]interface{}{"color": color, "gt": gt, "gte": gte, "lt": lt, "lte": lte}
+	}
+
+	assert.NoError(t, validateColorScale([]interface{}{
+		scale("green", unset, unset, 100, unset),
+		scale("yellow", unset, 100, unset, 500),
+		scale("red", 500, unset, unset, unset),
+	}))
+	assert.NoError(t, validateColorScale([]interface{}{
+		scale("cerise", 40, unset, unset, unset),
+		scale("vivid_yellow", unset, unset, unset, 40),
+	}))
+
+	assert.Error(t, validateColorScale([]interface{}{scale("red", unset, unset, unset, unset)}), "no threshold")
+	assert.Error(t, validateColorScale([]interface{}{scale("red", 1, 2, unset, unset)}), "gt and gte")
+	assert.Error(t, validateColorScale([]interface{}{scale("red", unset, unset, 1, 2)}), "lt and lte")
+	assert.Error(t, validateColorScale([]interface{}{scale("red", 10, unset, 5, unset)}), "empty range")
+	assert.Error(t, validateColorScale([]interface{}{
+		scale("green", unset, unset, unset, 100),
+		scale("red", unset, 100, unset, unset),
+	}), "overlap at the boundary")
+}
@@ -0,0 +1,123 @@
+package signalfx
+
+import (
+	chart "github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/detector"
+)
+
+/*
+Builds the publish label options of a chart from the `viz_options` set. Charts
+that don't support per-plot colors (e.g. table charts) set includePaletteIndex
+to false.
+*/
+func buildVizOptions(tfVizOptions []interface{}, includePaletteIndex bool) []*chart.PublishLabelOptions {
+	vizList := make([]*chart.PublishLabelOptions, len(tfVizOptions))
+	for i, v := range tfVizOptions {
+		v := v.(map[string]interface{})
+		item := &chart.PublishLabelOptions{
+			Label: v["label"].(string),
+		}
+		if val, ok := v["display_name"].(string); ok && val != "" {
+			item.DisplayName = val
+		}
+		if val, ok := v["color"].(string); ok && includePaletteIndex {
+			if elem, ok := PaletteColors[val]; ok {
+				i := int32(elem)
+				item.PaletteIndex = &i
+			}
+		}
+		if val, ok := v["plot_type"].(string); ok && val != "" {
+			item.PlotType = val
+		}
+		if val, ok := v["axis"].(string); ok && val == "right" {
+			item.YAxis = int32(1)
+		}
+		if val, ok := v["value_unit"].(string); ok && val != "" {
+			item.ValueUnit = val
+		}
+		if val, ok := v["value_suffix"].(string); ok && val != "" {
+			item.ValueSuffix = val
+		}
+		if val, ok := v["value_prefix"].(string); ok && val != "" {
+			item.ValuePrefix = val
+		}
+
+		vizList[i] = item
+	}
+	return vizList
+}
+
+/*
+Flattens the publish label options of a chart into `viz_options`. Only time
+charts support the `axis` and `plot_type` fields, so they are only set when
+includeTimeFields is true.
+*/
+func flattenVizOptions(options []*chart.PublishLabelOptions, includeTimeFields bool) ([]map[string]interface{}, error) {
+	vizList := make([]map[string]interface{}, len(options))
+	for i, plo := range options {
+		color := ""
+		if plo.PaletteIndex != nil {
+			// We might not have a color, so tread lightly
+			c, err := getNameFromPaletteColorsByIndex(int(*plo.PaletteIndex))
+			if err != nil {
+				return nil, err
+			}
+			// Ok, we can set the color now
+			color = c
+		}
+
+		item := map[string]interface{}{
+			"label":        plo.Label,
+			"display_name": plo.DisplayName,
+			"color":        color,
+			"value_unit":   plo.ValueUnit,
+			"value_suffix": plo.ValueSuffix,
+			"value_prefix": plo.ValuePrefix,
+		}
+		if includeTimeFields {
+			axis := "left"
+			if plo.YAxis == 1 {
+				axis = "right"
+			}
+			item["axis"] = axis
+			item["plot_type"] = plo.PlotType
+		}
+		vizList[i] = item
+	}
+	return vizList, nil
+}
+
+/*
+Detectors use their own publish label options model, which is a subset of the
+chart one, so they are converted from the shared chart representation.
+*/
+func buildDetectorVizOptions(tfVizOptions []interface{}) []*detector.PublishLabelOptions {
+	chartOptions := buildVizOptions(tfVizOptions, true)
+	vizList := make([]*detector.PublishLabelOptions, len(chartOptions))
+	for i, plo := range chartOptions {
+		vizList[i] = &detector.PublishLabelOptions{
+			Label:        plo.Label,
+			DisplayName:  plo.DisplayName,
+			PaletteIndex: plo.PaletteIndex,
+			ValueUnit:    plo.ValueUnit,
+			ValueSuffix:  plo.ValueSuffix,
+			ValuePrefix:  plo.ValuePrefix,
+		}
+	}
+	return vizList
+}
+
+func flattenDetectorVizOptions(options []*detector.PublishLabelOptions) ([]map[string]interface{}, error) {
+	chartOptions := make([]*chart.PublishLabelOptions, len(options))
+	for i, plo := range options {
+		chartOptions[i] = &chart.PublishLabelOptions{
+			Label:        plo.Label,
+			DisplayName:  plo.DisplayName,
+			PaletteIndex: plo.PaletteIndex,
+			ValueUnit:    plo.ValueUnit,
+			ValueSuffix:  plo.ValueSuffix,
+			ValuePrefix:  plo.ValuePrefix,
+		}
+	}
+	return flattenVizOptions(chartOptions, false)
+}
@@ -0,0 +1,205 @@
+package signalfx
+
+import (
+	"testing"
+
+	chart "github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestBuildVizOptions(t *testing.T) {
+	cases := []struct {
+		name                string
+		tf                  map[string]interface{}
+		includePaletteIndex bool
+		expected            *chart.PublishLabelOptions
+	}{
+		{
+			name:     "label",
+			tf:       map[string]interface{}{"label": "A"},
+			expected: &chart.PublishLabelOptions{Label: "A"},
+		},
+		{
+			name:                "color",
+			tf:                  map[string]interface{}{"label": "A", "color": "azure"},
+			includePaletteIndex: true,
+			expected:            &chart.PublishLabelOptions{Label: "A", PaletteIndex: int32Ptr(2)},
+		},
+		{
+			name:     "color without palette index",
+			tf:       map[string]interface{}{"label": "A", "color": "azure"},
+			expected: &chart.PublishLabelOptions{Label: "A"},
+		},
+		{
+			name:                "unknown color",
+			tf:                  map[string]interface{}{"label": "A", "color": "chartreuse"},
+			includePaletteIndex: true,
+			expected:            &chart.PublishLabelOptions{Label: "A"},
+		},
+		{
+			name:     "axis left",
+			tf:       map[string]interface{}{"label": "A", "axis": "left"},
+			expected: &chart.PublishLabelOptions{Label: "A", YAxis: 0},
+		},
+		{
+			name:     "axis right",
+			tf:       map[string]interface{}{"label": "A", "axis": "right"},
+			expected: &chart.PublishLabelOptions{Label: "A", YAxis: 1},
+		},
+		{
+			name:     "display_name",
+			tf:       map[string]interface{}{"label": "A", "display_name": "Requests"},
+			expected: &chart.PublishLabelOptions{Label: "A", DisplayName: "Requests"},
+		},
+		{
+			name:     "value_unit",
+			tf:       map[string]interface{}{"label": "A", "value_unit": "Byte"},
+			expected: &chart.PublishLabelOptions{Label: "A", ValueUnit: "Byte"},
+		},
+		{
+			name:     "value_prefix",
+			tf:       map[string]interface{}{"label": "A", "value_prefix": "$"},
+			expected: &chart.PublishLabelOptions{Label: "A", ValuePrefix: "$"},
+		},
+		{
+			name:     "value_suffix",
+			tf:       map[string]interface{}{"label": "A", "value_suffix": "%"},
+			expected: &chart.PublishLabelOptions{Label: "A", ValueSuffix: "%"},
+		},
+		{
+			name:     "plot_type",
+			tf:       map[string]interface{}{"label": "A", "plot_type": "AreaChart"},
+			expected: &chart.PublishLabelOptions{Label: "A", PlotType: "AreaChart"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := buildVizOptions([]interface{}{tc.tf}, tc.includePaletteIndex)
+			assert.Equal(t, []*chart.PublishLabelOptions{tc.expected}, result)
+		})
+	}
+}
+
+func TestFlattenVizOptions(t *testing.T) {
+	cases := []struct {
+		name              string
+		api               *chart.PublishLabelOptions
+		includeTimeFields bool
+		expected          map[string]interface{}
+	}{
+		{
+			name: "non time chart",
+			api: &chart.PublishLabelOptions{
+				Label:        "A",
+				DisplayName:  "Requests",
+				PaletteIndex: int32Ptr(2),
+				ValueUnit:    "Byte",
+				ValuePrefix:  "$",
+				ValueSuffix:  "%",
+			},
+			expected: map[string]interface{}{
+				"label":        "A",
+				"display_name": "Requests",
+				"color":        "azure",
+				"value_unit":   "Byte",
+				"value_prefix": "$",
+				"value_suffix": "%",
+			},
+		},
+		{
+			name: "time chart",
+			api: &chart.PublishLabelOptions{
+				Label:    "A",
+				PlotType: "ColumnChart",
+				YAxis:    1,
+			},
+			includeTimeFields: true,
+			expected: map[string]interface{}{
+				"label":        "A",
+				"display_name": "",
+				"color":        "",
+				"axis":         "right",
+				"plot_type":    "ColumnChart",
+				"value_unit":   "",
+				"value_prefix": "",
+				"value_suffix": "",
+			},
+		},
+		{
+			name:              "time chart default axis",
+			api:               &chart.PublishLabelOptions{Label: "A"},
+			includeTimeFields: true,
+			expected: map[string]interface{}{
+				"label":        "A",
+				"display_name": "",
+				"color":        "",
+				"axis":         "left",
+				"plot_type":    "",
+				"value_unit":   "",
+				"value_prefix": "",
+				"value_suffix": "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := flattenVizOptions([]*chart.PublishLabelOptions{tc.api}, tc.includeTimeFields)
+			assert.NoError(t, err)
+			assert.Equal(t, []map[string]interface{}{tc.expected}, result)
+		})
+	}
+}
+
+func TestFlattenVizOptionsUnknownColor(t *testing.T) {
+	_, err := flattenVizOptions([]*chart.PublishLabelOptions{{Label: "A", PaletteIndex: int32Ptr(44)}}, false)
+	assert.Error(t, err)
+}
+
+func TestVizOptionsRoundTrip(t *testing.T) {
+	tf := map[string]interface{}{
+		"label":        "A",
+		"display_name": "Requests",
+		"color":        "emerald",
+		"axis":         "right",
+		"plot_type":    "LineChart",
+		"value_unit":   "Second",
+		"value_prefix": "~",
+		"value_suffix": "s",
+	}
+
+	result, err := flattenVizOptions(buildVizOptions([]interface{}{tf}, true), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{tf}, result)
+}
+
+func TestDetectorVizOptionsRoundTrip(t *testing.T) {
+	tf := map[string]interface{}{
+		"label":        "A",
+		"display_name": "Requests",
+		"color":        "emerald",
+		"value_unit":   "Second",
+		"value_prefix": "~",
+		"value_suffix": "s",
+	}
+
+	built := buildDetectorVizOptions([]interface{}{tf})
+	assert.Equal(t, []*detector.PublishLabelOptions{{
+		Label:        "A",
+		DisplayName:  "Requests",
+		PaletteIndex: int32Ptr(13),
+		ValueUnit:    "Second",
+		ValuePrefix:  "~",
+		ValueSuffix:  "s",
+	}}, built)
+
+	result, err := flattenDetectorVizOptions(built)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{tf}, result)
+}